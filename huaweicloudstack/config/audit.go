@@ -0,0 +1,158 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// AuditLogLevelMutating records the requests which may change the resources, such as POST, PUT, PATCH and DELETE.
+	AuditLogLevelMutating = "mutating"
+	// AuditLogLevelAll records all requests.
+	AuditLogLevelAll = "all"
+)
+
+// AuditLogger appends a JSON line to the audit log file for each API request.
+type AuditLogger struct {
+	Level string
+
+	file *os.File
+	lock sync.Mutex
+}
+
+// AuditRecord is a line of the audit log.
+type AuditRecord struct {
+	Timestamp string `json:"timestamp"`
+	// ResourceAddress, ResourceType, ResourceID and Operation identify the Terraform operation which sends the
+	// request, they are empty for the requests which are sent when configuring the provider.
+	ResourceAddress string `json:"resource_address,omitempty"`
+	ResourceType    string `json:"resource_type,omitempty"`
+	ResourceID      string `json:"resource_id,omitempty"`
+	Operation       string `json:"operation,omitempty"`
+	Method          string `json:"method"`
+	URL             string `json:"url"`
+	StatusCode      int    `json:"status_code,omitempty"`
+	RequestID       string `json:"request_id,omitempty"`
+	DurationMs      int64  `json:"duration_ms"`
+	Error           string `json:"error,omitempty"`
+}
+
+// NewAuditLogger returns a logger which appends the records to the file, the file is created if it does not exist.
+func NewAuditLogger(path, level string) (*AuditLogger, error) {
+	if level != AuditLogLevelMutating && level != AuditLogLevelAll {
+		return nil, fmt.Errorf("invalid audit log level %q, valid values are %q and %q", level,
+			AuditLogLevelMutating, AuditLogLevelAll)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening the audit log file: %s", err)
+	}
+	return &AuditLogger{
+		Level: level,
+		file:  file,
+	}, nil
+}
+
+// shouldLog returns whether the requests of the method are recorded at the level of the logger.
+func (l *AuditLogger) shouldLog(method string) bool {
+	if l.Level == AuditLogLevelAll {
+		return true
+	}
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// Write appends the record to the file as a single line, the lines of concurrent requests are never interleaved.
+func (l *AuditLogger) Write(record AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, err = l.file.Write(append(line, '\n'))
+	return err
+}
+
+// AuditRoundTripper satisfies the http.RoundTripper interface and is used to write the audit log of each request,
+// the duration includes the retries of the connection errors. The clients of huaweicloud-sdk-go-v3 (created by
+// NewHcClient) send the requests without the context of the operation, so their records have no resource fields.
+type AuditRoundTripper struct {
+	Rt     http.RoundTripper
+	Config *HcsConfig
+}
+
+// RoundTrip performs a round-trip HTTP request and writes the audit log of it.
+func (art *AuditRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	logger := art.Config.AuditLogger
+	if logger == nil || !logger.shouldLog(request.Method) {
+		return art.Rt.RoundTrip(request)
+	}
+
+	start := time.Now()
+	record := AuditRecord{
+		Timestamp: start.UTC().Format(time.RFC3339Nano),
+		Method:    request.Method,
+		URL:       redactURL(request.URL),
+	}
+	response, err := art.Rt.RoundTrip(request)
+	record.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.StatusCode = response.StatusCode
+		record.RequestID = getRequestID(response.Header)
+	}
+
+	// the ID of the resource is read after the response, because it is set by the operation after the creation
+	if op := operationFromContext(request.Context()); op != nil {
+		record.ResourceType = op.typeName
+		record.Operation = op.name
+		record.ResourceID = op.d.Id()
+		if record.ResourceID == "" && op.name == "create" && !op.dataSource {
+			// the ID is not set until the response of the creation is handled, so the record is written when the
+			// operation ends
+			op.deferAudit(record)
+			return response, err
+		}
+		record.ResourceAddress = op.address(record.ResourceID)
+	}
+
+	writeAudit(logger, record)
+	return response, err
+}
+
+// writeAudit writes the record of a request which has been sent, so a failed write does not fail it.
+func writeAudit(logger *AuditLogger, record AuditRecord) {
+	if err := logger.Write(record); err != nil {
+		log.Printf("[WARN] failed to write the audit log of %s %s: %s", record.Method, record.URL, err)
+	}
+}
+
+func (op *resourceOperation) deferAudit(record AuditRecord) {
+	op.auditLock.Lock()
+	defer op.auditLock.Unlock()
+	op.pendingAudits = append(op.pendingAudits, record)
+}
+
+// writePendingAudits writes the records of the requests sent before the ID of the new resource is set, the ID is
+// empty if the creation fails.
+func (op *resourceOperation) writePendingAudits(logger *AuditLogger) {
+	op.auditLock.Lock()
+	records := op.pendingAudits
+	op.pendingAudits = nil
+	op.auditLock.Unlock()
+
+	resourceID := op.d.Id()
+	for _, record := range records {
+		record.ResourceID = resourceID
+		record.ResourceAddress = op.address(resourceID)
+		writeAudit(logger, record)
+	}
+}
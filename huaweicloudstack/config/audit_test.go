@@ -0,0 +1,192 @@
+package config
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func readAuditRecords(t *testing.T, path string) []AuditRecord {
+	file, err := os.Open(path)
+	th.AssertNoErr(t, err)
+	defer file.Close()
+
+	var records []AuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		th.AssertNoErr(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	th.AssertNoErr(t, scanner.Err())
+	return records
+}
+
+func TestAuditRoundTripper(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "request-"+r.Method)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer api.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := NewAuditLogger(path, AuditLogLevelMutating)
+	th.AssertNoErr(t, err)
+
+	cfg := &HcsConfig{AuditLogger: logger}
+	cfg.Metadata = cfg
+	client := &http.Client{
+		Transport: &AuditRoundTripper{
+			Rt:     http.DefaultTransport,
+			Config: cfg,
+		},
+	}
+
+	send := func(ctx context.Context, method string) {
+		request, err := http.NewRequestWithContext(ctx, method, api.URL+"/v1/vpcs?password=abc", nil)
+		th.AssertNoErr(t, err)
+		response, err := client.Do(request)
+		th.AssertNoErr(t, err)
+		response.Body.Close()
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		CreateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			send(GetHcsConfig(meta).traceContext, "POST")
+			// the ID is set after the response of the creation
+			d.SetId("vpc-1")
+			send(GetHcsConfig(meta).traceContext, "PUT")
+			// the GET requests are not recorded at the mutating level
+			send(GetHcsConfig(meta).traceContext, "GET")
+			return nil
+		},
+		DeleteContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			send(GetHcsConfig(meta).traceContext, "DELETE")
+			d.SetId("")
+			return nil
+		},
+	}
+	TraceResource("hcs_vpc", resource)
+
+	d := resource.TestResourceData()
+	diags := resource.CreateContext(context.Background(), d, &cfg.Config)
+	th.AssertEquals(t, false, diags.HasError())
+	diags = resource.DeleteContext(context.Background(), d, &cfg.Config)
+	th.AssertEquals(t, false, diags.HasError())
+	// the requests sent outside the Terraform operations are recorded without the resource
+	send(context.Background(), "DELETE")
+
+	records := readAuditRecords(t, path)
+	th.AssertEquals(t, 4, len(records))
+
+	// the request sent after the ID is set is written when the response is received
+	th.AssertEquals(t, "PUT", records[0].Method)
+	th.AssertEquals(t, "vpc-1", records[0].ResourceID)
+	th.AssertEquals(t, "hcs_vpc.vpc-1", records[0].ResourceAddress)
+
+	// the request which creates the resource is written with the new ID when the operation ends
+	th.AssertEquals(t, "hcs_vpc", records[1].ResourceType)
+	th.AssertEquals(t, "vpc-1", records[1].ResourceID)
+	th.AssertEquals(t, "hcs_vpc.vpc-1", records[1].ResourceAddress)
+	th.AssertEquals(t, "create", records[1].Operation)
+	th.AssertEquals(t, "POST", records[1].Method)
+	th.AssertEquals(t, api.URL+"/v1/vpcs?password=%2A%2A%2A", records[1].URL)
+	th.AssertEquals(t, http.StatusCreated, records[1].StatusCode)
+	th.AssertEquals(t, "request-POST", records[1].RequestID)
+
+	th.AssertEquals(t, "delete", records[2].Operation)
+	th.AssertEquals(t, "vpc-1", records[2].ResourceID)
+	th.AssertEquals(t, "hcs_vpc.vpc-1", records[2].ResourceAddress)
+
+	th.AssertEquals(t, "", records[3].ResourceType)
+	th.AssertEquals(t, "", records[3].ResourceAddress)
+	th.AssertEquals(t, "DELETE", records[3].Method)
+	th.AssertEquals(t, "request-DELETE", records[3].RequestID)
+}
+
+func TestAuditRoundTripper_dataSource(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer api.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := NewAuditLogger(path, AuditLogLevelAll)
+	th.AssertNoErr(t, err)
+
+	cfg := &HcsConfig{AuditLogger: logger}
+	cfg.Metadata = cfg
+	client := &http.Client{
+		Transport: &AuditRoundTripper{
+			Rt:     http.DefaultTransport,
+			Config: cfg,
+		},
+	}
+
+	dataSource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			request, err := http.NewRequestWithContext(GetHcsConfig(meta).traceContext, "GET", api.URL+"/v1/vpcs", nil)
+			th.AssertNoErr(t, err)
+			response, err := client.Do(request)
+			th.AssertNoErr(t, err)
+			response.Body.Close()
+			d.SetId("vpcs")
+			return nil
+		},
+	}
+	TraceDataSource("hcs_vpcs", dataSource)
+
+	diags := dataSource.ReadContext(context.Background(), dataSource.TestResourceData(), &cfg.Config)
+	th.AssertEquals(t, false, diags.HasError())
+
+	records := readAuditRecords(t, path)
+	th.AssertEquals(t, 1, len(records))
+	th.AssertEquals(t, "data.hcs_vpcs", records[0].ResourceAddress)
+	th.AssertEquals(t, "read", records[0].Operation)
+}
+
+func TestAuditRoundTripper_sdkClient(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "request-"+r.Method)
+	}))
+	defer api.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := NewAuditLogger(path, AuditLogLevelMutating)
+	th.AssertNoErr(t, err)
+
+	cfg := &HcsConfig{AuditLogger: logger}
+	httpConfig, err := buildHTTPConfig(cfg, "hss")
+	th.AssertNoErr(t, err)
+	client := &http.Client{Transport: httpConfig.HttpTransport}
+
+	// the SDK sends the requests without any context
+	for _, method := range []string{"GET", "POST"} {
+		request, err := http.NewRequest(method, api.URL+"/v5/host-management/hosts", nil)
+		th.AssertNoErr(t, err)
+		response, err := client.Do(request)
+		th.AssertNoErr(t, err)
+		response.Body.Close()
+	}
+
+	records := readAuditRecords(t, path)
+	th.AssertEquals(t, 1, len(records))
+	th.AssertEquals(t, "POST", records[0].Method)
+	th.AssertEquals(t, "request-POST", records[0].RequestID)
+	th.AssertEquals(t, "", records[0].ResourceAddress)
+}
+
+func TestNewAuditLoggerInvalidLevel(t *testing.T) {
+	_, err := NewAuditLogger(filepath.Join(t.TempDir(), "audit.log"), "debug")
+	th.AssertEquals(t, true, err != nil)
+}
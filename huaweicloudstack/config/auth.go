@@ -51,17 +51,10 @@ type Profile struct {
 }
 
 func buildClient(c *HcsConfig) error {
-	if c.Token != "" {
-		return buildClientByToken(c)
-	} else if c.AccessKey != "" && c.SecretKey != "" {
-		return buildClientByAKSK(c)
-	} else if c.Password != "" && (c.Username != "" || c.UserID != "") {
-		return buildClientByPassword(c)
-	} else if c.SharedConfigFile != "" {
-		return buildClientByConfig(c)
-	}
-
-	return buildClientByMeta(c)
+	c.takeCredentialSnapshot()
+	c.CredentialProvider = newCredentialProvider(c)
+	log.Printf("[DEBUG] authenticating with the credentials of %s", c.CredentialProvider.Name())
+	return c.CredentialProvider.Retrieve(c)
 }
 
 func generateTLSConfig(c *HcsConfig) (*tls.Config, error) {
@@ -81,6 +74,18 @@ func generateTLSConfig(c *HcsConfig) (*tls.Config, error) {
 		config.RootCAs = caCertPool
 	}
 
+	if c.EndpointProfile != nil {
+		if bundles := c.EndpointProfile.CABundles(c.Region); len(bundles) > 0 {
+			if config.RootCAs == nil {
+				// trust the CA bundles in addition to the system certificates
+				if config.RootCAs, _ = x509.SystemCertPool(); config.RootCAs == nil {
+					config.RootCAs = x509.NewCertPool()
+				}
+			}
+			config.RootCAs.AppendCertsFromPEM(bundles)
+		}
+	}
+
 	if c.ClientCertFile != "" && c.ClientKeyFile != "" {
 		clientCert, _, err := pathorcontents.Read(c.ClientCertFile)
 		if err != nil {
@@ -126,9 +131,21 @@ func genClient(c *HcsConfig, ao golangsdk.AuthOptionsProvider) (*golangsdk.Provi
 	}
 
 	client.HTTPClient = http.Client{
-		Transport: &LogRoundTripper{
-			Rt:         transport,
-			MaxRetries: c.MaxRetries,
+		Transport: &AuditRoundTripper{
+			Rt: &TracingRoundTripper{
+				Rt: &LogRoundTripper{
+					Rt: &RateLimitRoundTripper{
+						Rt: &CredentialRoundTripper{
+							Rt:     c.Recorder.Wrap(transport),
+							Config: c,
+						},
+						Config: c,
+					},
+					MaxRetries: c.MaxRetries,
+				},
+				Config: c,
+			},
+			Config: c,
 		},
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			if client.AKSKAuthOptions.AccessKey != "" {
@@ -142,10 +159,9 @@ func genClient(c *HcsConfig, ao golangsdk.AuthOptionsProvider) (*golangsdk.Provi
 		},
 	}
 
-	if c.MaxRetries > 0 {
-		client.MaxBackoffRetries = uint(c.MaxRetries)
-		client.RetryBackoffFunc = retryBackoffFunc
-	}
+	setRetryPolicy(client, c.GetRetryPolicy(""))
+	// the token is renewed by the ReauthFunc while the other requests are being sent
+	client.UseTokenLock()
 
 	// Validate authentication normally.
 	err = openstack.Authenticate(client, ao)
@@ -156,6 +172,28 @@ func genClient(c *HcsConfig, ao golangsdk.AuthOptionsProvider) (*golangsdk.Provi
 	return client, nil
 }
 
+// cloneProviderClient returns a copy of the ProviderClient to be customized for the service clients.
+// The token of the copy is renewed by re-authenticating the client, so the token is shared by all copies.
+func cloneProviderClient(client *golangsdk.ProviderClient) *golangsdk.ProviderClient {
+	clone := new(golangsdk.ProviderClient)
+	*clone = *client
+	clone.TokenID = client.Token()
+	if client.ReauthFunc == nil {
+		return clone
+	}
+
+	clone.UseTokenLock()
+	clone.ReauthFunc = func() error {
+		// the copy is locked by Reauthenticate, so its token is accessed directly
+		if err := client.Reauthenticate(clone.TokenID); err != nil {
+			return err
+		}
+		clone.TokenID = client.Token()
+		return nil
+	}
+	return clone
+}
+
 func convertHCSClientToHWClient(client *golangsdk.ProviderClient) *hw_golangsdk.ProviderClient {
 
 	EndpointLocator := func(x hw_golangsdk.EndpointOpts) (string, error) {
@@ -185,6 +223,20 @@ func convertHCSClientToHWClient(client *golangsdk.ProviderClient) *hw_golangsdk.
 		DelegatedProject: client.AKSKAuthOptions.DelegatedProject,
 		WithUserCatalog:  client.AKSKAuthOptions.WithUserCatalog,
 	}
+
+	// The upstream ProviderClient only retries the StatusTooManyRequests response with the RetryBackoffFunc,
+	// so the other responses which are retryable in the retry policy are retried by its HTTP client.
+	httpClient := client.HTTPClient
+	if client.RetryableFunc != nil && client.RetryBackoffFunc != nil {
+		httpClient.Transport = &RetryRoundTripper{
+			Rt:          httpClient.Transport,
+			MaxAttempts: client.MaxBackoffRetries,
+			RetryableFunc: func(respErr *golangsdk.ErrUnexpectedResponseCode) bool {
+				return respErr.Actual != http.StatusTooManyRequests && client.RetryableFunc(respErr)
+			},
+			BackoffFunc: client.RetryBackoffFunc,
+		}
+	}
 	hwClient := &hw_golangsdk.ProviderClient{
 		IdentityBase:     client.IdentityBase,
 		IdentityEndpoint: client.IdentityEndpoint,
@@ -192,8 +244,7 @@ func convertHCSClientToHWClient(client *golangsdk.ProviderClient) *hw_golangsdk.
 		ProjectID:        client.ProjectID,
 		DomainID:         client.DomainID,
 		EndpointLocator:  EndpointLocator,
-		HTTPClient:       client.HTTPClient,
-		ReauthFunc:       client.ReauthFunc,
+		HTTPClient:       httpClient,
 		AKSKAuthOptions:  AKSKAuthOptions,
 		Context:          client.Context,
 		RetryBackoffFunc: func(ctx context.Context, rsp *hw_golangsdk.ErrUnexpectedResponseCode, err error, status uint) error {
@@ -217,6 +268,17 @@ func convertHCSClientToHWClient(client *golangsdk.ProviderClient) *hw_golangsdk.
 
 	// Initial TokenLock
 	hwClient.UseTokenLock()
+	if client.ReauthFunc != nil {
+		hwClient.TokenID = client.Token()
+		hwClient.ReauthFunc = func() error {
+			// the token is renewed by the HCS client, which is shared by the service clients of the SDK-v3
+			if err := client.Reauthenticate(hwClient.TokenID); err != nil {
+				return err
+			}
+			hwClient.TokenID = client.Token()
+			return nil
+		}
+	}
 
 	return hwClient
 }
@@ -230,16 +292,16 @@ func genClients(c *HcsConfig, projectAuthOptions, domainAuthOptions golangsdk.Au
 	c.HwClient = convertHCSClientToHWClient(client)
 
 	client, err = genClient(c, domainAuthOptions)
-	if err == nil {
-		c.HcsDomainClient = client
-		c.DomainClient = convertHCSClientToHWClient(client)
+	if err != nil {
+		return err
 	}
+	c.HcsDomainClient = client
+	c.DomainClient = convertHCSClientToHWClient(client)
 
 	if c.AssumeRoleAgency != "" {
 		c.DelegatedDomainId = client.DelegatedDomainId
 	}
-
-	return err
+	return nil
 }
 
 func buildClientByToken(c *HcsConfig) error {
@@ -427,23 +489,60 @@ func buildClientByPassword(c *HcsConfig) error {
 		ao.Password = c.Password
 		ao.Username = c.Username
 		ao.UserID = c.UserID
+		// the token expires in 24 hours, it is renewed with the password after it is rejected
+		ao.AllowReauth = true
 	}
 	return genClients(c, projectAuthOptions, domainAuthOptions)
 }
 
 func buildClientByAgency(c *HcsConfig) error {
-	client, err := c.HcIamV3Client(c.Region)
+	assumeRoles := c.AssumeRoles
+	if len(assumeRoles) == 0 {
+		assumeRoles = []AssumeRole{
+			{
+				AgencyName: c.AssumeRoleAgency,
+				DomainName: c.AssumeRoleDomain,
+			},
+		}
+	}
+
+	// the first hop is authorized in the domain of the provider, and the subsequent hops are authorized in the
+	// domain delegated by the previous one.
+	domainID := c.DomainID
+	for i, role := range assumeRoles {
+		// the project of the previous hop does not belong to the domain delegated by it, so the subsequent hops
+		// use the default project of the region, whose name is the same as the region, if it is not specified.
+		if i > 0 && role.ProjectName == "" {
+			role.ProjectName = c.Region
+		}
+		log.Printf("[DEBUG] assume role %d/%d: switching to agency %s of domain %s", i+1, len(assumeRoles),
+			role.AgencyName, role.DomainName)
+		if err := assumeRoleByAgency(c, role, domainID); err != nil {
+			return err
+		}
+		domainID = c.DelegatedDomainId
+	}
+
+	return nil
+}
+
+func assumeRoleByAgency(c *HcsConfig, role AssumeRole, domainID string) error {
+	client, err := c.HcIamV3ClientWithDomain(c.Region, domainID)
 	if err != nil {
 		return fmt.Errorf("Error creating HuaweiCloudStack IAM client: %s", err)
 	}
 
+	durationSeconds := assumeRoleDuration
+	if role.Duration > 0 {
+		durationSeconds = int32(role.Duration)
+	}
+
 	request := &iam_model.CreateTemporaryAccessKeyByAgencyRequest{}
-	domainNameAssumeRoleIdentityAssumerole := c.AssumeRoleDomain
-	durationSecondsAssumeRoleIdentityAssumerole := assumeRoleDuration
+	domainNameAssumeRoleIdentityAssumerole := role.DomainName
 	assumeRoleIdentity := &iam_model.IdentityAssumerole{
-		AgencyName:      c.AssumeRoleAgency,
+		AgencyName:      role.AgencyName,
 		DomainName:      &domainNameAssumeRoleIdentityAssumerole,
-		DurationSeconds: &durationSecondsAssumeRoleIdentityAssumerole,
+		DurationSeconds: &durationSeconds,
 	}
 	var listMethodsIdentity = []iam_model.AgencyAuthIdentityMethods{
 		iam_model.GetAgencyAuthIdentityMethodsEnum().ASSUME_ROLE,
@@ -460,19 +559,25 @@ func buildClientByAgency(c *HcsConfig) error {
 	}
 	response, err := client.CreateTemporaryAccessKeyByAgency(request)
 	if err != nil {
-		return fmt.Errorf("Error Creating temporary accesskey by agency: %s", err)
+		return fmt.Errorf("Error Creating temporary accesskey by agency %s: %s", role.AgencyName, err)
 	}
 	c.AccessKey, c.SecretKey, c.SecurityToken = response.Credential.Access, response.Credential.Secret, response.Credential.Securitytoken
+	// the temporary credentials are refreshed by assuming the roles again before they expire
+	if expiresAt, err := time.Parse(time.RFC3339, response.Credential.ExpiresAt); err == nil {
+		c.SecurityKeyExpiresAt = expiresAt
+	} else {
+		log.Printf("[WARN] unable to parse the expiration time of the agency %s: %s", role.AgencyName, err)
+	}
+	c.AssumeRoleAgency, c.AssumeRoleDomain = role.AgencyName, role.DomainName
 
-	return buildClientByAKSK(c)
-}
-
-func (c *HcsConfig) reloadSecurityKey() error {
-	err := getAuthConfigByMeta(c)
-	if err != nil {
-		return fmt.Errorf("Error reloading Auth credentials from ECS Metadata API: %s", err)
+	// the first hop uses the project of the provider if the project name is not specified
+	if role.ProjectName != "" {
+		c.TenantName = role.ProjectName
+		c.TenantID = ""
 	}
-	log.Printf("Successfully reload metadata security key, which will expire at: %s", c.SecurityKeyExpiresAt)
+	c.RegionProjectIDMap = make(map[string]string)
+	c.ScopedProjectIDMap = make(map[string]string)
+
 	return buildClientByAKSK(c)
 }
 
@@ -0,0 +1,224 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+// testIAMProjects is the projects in the stub IAM server, the domain of the caller is identified by the security
+// token, and the provider itself (without the security token) belongs to domain-0.
+var testIAMProjects = map[string][]map[string]string{
+	"": {
+		{"id": "project-0-id", "name": "project-0", "domain_id": "domain-0"},
+	},
+	"token-agency-1": {
+		{"id": "project-1-id", "name": "project-1", "domain_id": "domain-1"},
+		{"id": "region-1-id-1", "name": "region-1", "domain_id": "domain-1"},
+	},
+	"token-agency-2": {
+		{"id": "region-1-id-2", "name": "region-1", "domain_id": "domain-2"},
+		{"id": "project-2-id", "name": "project-2", "domain_id": "domain-2"},
+	},
+}
+
+// signedAccessKey returns the access key of the request signed by huaweicloud-sdk-go-v3.
+func signedAccessKey(authorization string) string {
+	if i := strings.Index(authorization, "Access="); i >= 0 {
+		return strings.SplitN(authorization[i+len("Access="):], ",", 2)[0]
+	}
+	return ""
+}
+
+// handleTestIAM registers the APIs of the stub IAM server, and returns the assume role calls in the form of
+// {access key}:{agency}@{domain ID}.
+func handleTestIAM(t *testing.T) func() []string {
+	var (
+		calls []string
+		lock  sync.Mutex
+	)
+
+	th.Mux.HandleFunc("/v3.0/OS-CREDENTIAL/securitytokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		var body struct {
+			Auth struct {
+				Identity struct {
+					AssumeRole struct {
+						AgencyName string `json:"agency_name"`
+					} `json:"assume_role"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		agency := body.Auth.Identity.AssumeRole.AgencyName
+
+		lock.Lock()
+		calls = append(calls, fmt.Sprintf("%s:%s@%s", signedAccessKey(r.Header.Get("Authorization")), agency,
+			r.Header.Get("X-Domain-Id")))
+		count := len(calls)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"credential": {"access": "AK-%[1]s-%[2]d", "secret": "SK-%[1]s-%[2]d", `+
+			`"securitytoken": "token-%[1]s", "expires_at": "%[3]s"}}`,
+			agency, count, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	})
+
+	th.Mux.HandleFunc("/v3/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		name := r.URL.Query().Get("name")
+		projects := make([]map[string]string, 0)
+		for _, project := range testIAMProjects[r.Header.Get("X-Security-Token")] {
+			if name == "" || project["name"] == name {
+				projects = append(projects, project)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]interface{}{"projects": projects}))
+	})
+
+	return func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, calls...)
+	}
+}
+
+func newAssumeRoleTestConfig() *HcsConfig {
+	cfg := &HcsConfig{}
+	cfg.Region = "region-1"
+	cfg.DomainID = "domain-0"
+	cfg.TenantName = "project-1"
+	cfg.AccessKey, cfg.SecretKey = "AK-0", "SK-0"
+	cfg.IdentityEndpoint = th.Endpoint() + "v3"
+	cfg.Endpoints = map[string]string{"iam": th.Endpoint()}
+	cfg.RegionProjectIDMap = make(map[string]string)
+	cfg.ScopedProjectIDMap = make(map[string]string)
+	cfg.SecurityKeyLock = new(sync.Mutex)
+	cfg.RPLock = new(sync.Mutex)
+	cfg.Metadata = cfg
+	return cfg
+}
+
+func TestBuildClientByAgency(t *testing.T) {
+	cases := []struct {
+		name string
+		// agency is the agency specified by the legacy arguments
+		agency        string
+		roles         []AssumeRole
+		wantCalls     []string
+		wantProjectID string
+		wantDomainID  string
+		wantErr       bool
+	}{
+		{
+			name:          "single agency with the project of the provider",
+			agency:        "agency-1",
+			wantCalls:     []string{"AK-0:agency-1@domain-0"},
+			wantProjectID: "project-1-id",
+			wantDomainID:  "domain-1",
+		},
+		{
+			name: "chain with the project names",
+			roles: []AssumeRole{
+				{AgencyName: "agency-1", DomainName: "domain-name-1", ProjectName: "region-1"},
+				{AgencyName: "agency-2", DomainName: "domain-name-2", ProjectName: "project-2"},
+			},
+			wantCalls:     []string{"AK-0:agency-1@domain-0", "AK-agency-1-1:agency-2@domain-1"},
+			wantProjectID: "project-2-id",
+			wantDomainID:  "domain-2",
+		},
+		{
+			// the project-1 of the first hop does not exist in domain-2
+			name: "chain without the project names",
+			roles: []AssumeRole{
+				{AgencyName: "agency-1", DomainName: "domain-name-1"},
+				{AgencyName: "agency-2", DomainName: "domain-name-2"},
+			},
+			wantCalls:     []string{"AK-0:agency-1@domain-0", "AK-agency-1-1:agency-2@domain-1"},
+			wantProjectID: "region-1-id-2",
+			wantDomainID:  "domain-2",
+		},
+		{
+			name: "chain with a nonexistent project",
+			roles: []AssumeRole{
+				{AgencyName: "agency-1", DomainName: "domain-name-1"},
+				{AgencyName: "agency-2", DomainName: "domain-name-2", ProjectName: "project-1"},
+			},
+			wantCalls: []string{"AK-0:agency-1@domain-0", "AK-agency-1-1:agency-2@domain-1"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+			calls := handleTestIAM(t)
+
+			cfg := newAssumeRoleTestConfig()
+			cfg.AssumeRoleAgency = tc.agency
+			cfg.AssumeRoles = tc.roles
+
+			err := buildClientByAgency(cfg)
+			th.AssertDeepEquals(t, tc.wantCalls, calls())
+			if tc.wantErr {
+				th.AssertEquals(t, true, err != nil)
+				return
+			}
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, tc.wantProjectID, cfg.HcsHwClient.ProjectID)
+			th.AssertEquals(t, tc.wantDomainID, cfg.DelegatedDomainId)
+			th.AssertEquals(t, fmt.Sprintf("AK-agency-%d-%d", len(tc.wantCalls), len(tc.wantCalls)), cfg.AccessKey)
+		})
+	}
+}
+
+func TestRefreshCredentials_assumeRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleTestIAM(t)
+
+	cfg := newAssumeRoleTestConfig()
+	cfg.AssumeRoles = []AssumeRole{
+		{AgencyName: "agency-1", DomainName: "domain-name-1"},
+		{AgencyName: "agency-2", DomainName: "domain-name-2"},
+	}
+	cfg.AssumeRoleAgency = "agency-2"
+	cfg.CredentialProvider = &credentialProvider{
+		name:        "test",
+		refreshable: true,
+		retrieve: func(c *HcsConfig) error {
+			c.AccessKey, c.SecretKey = "AK-0-refreshed", "SK-0-refreshed"
+			return nil
+		},
+	}
+	cfg.takeCredentialSnapshot()
+
+	th.AssertNoErr(t, buildClientByAgency(cfg))
+	th.AssertEquals(t, "AK-agency-2-2", cfg.AccessKey)
+	th.AssertEquals(t, "region-1", cfg.TenantName)
+
+	// the whole chain is assumed again with the refreshed credentials of the provider
+	th.AssertNoErr(t, cfg.refreshCredentials())
+	th.AssertDeepEquals(t, []string{
+		"AK-0:agency-1@domain-0",
+		"AK-agency-1-1:agency-2@domain-1",
+		"AK-0-refreshed:agency-1@domain-0",
+		"AK-agency-1-3:agency-2@domain-1",
+	}, calls())
+	th.AssertEquals(t, "AK-agency-2-4", cfg.AccessKey)
+	th.AssertEquals(t, "SK-agency-2-4", cfg.SecretKey)
+	th.AssertEquals(t, "token-agency-2", cfg.SecurityToken)
+	th.AssertEquals(t, false, cfg.SecurityKeyExpiresAt.IsZero())
+}
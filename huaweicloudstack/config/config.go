@@ -4,11 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"math"
 	"os"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,11 +14,13 @@ import (
 	huaweiConfig "github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/mutexkv"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/recorder"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/domains"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/projects"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/users"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/obs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
 const (
@@ -42,9 +42,74 @@ type HcsConfig struct {
 	// HCS unique fields
 	DelegatedDomainId string
 
+	// RetryPolicy is the provider-level retry policy, and ServiceRetryPolicies overrides it for the service catalogs.
+	RetryPolicy          *RetryPolicy
+	ServiceRetryPolicies map[string]RetryPolicy
+
+	// RateLimiter limits the QPS of all API requests, and ServiceRateLimiters limits the QPS of the service catalogs.
+	RateLimiter         *RateLimiter
+	ServiceRateLimiters map[string]*RateLimiter
+
+	// EndpointDiscovery is the mode to build the endpoints which are not specified in the provider block,
+	// and UserEndpoints keeps the endpoints specified in the provider block, they are never overridden.
+	EndpointDiscovery string
+	UserEndpoints     map[string]string
+
+	// EndpointProfileFile is the path or contents of the endpoint profile, which is loaded to EndpointProfile.
+	EndpointProfileFile string
+	EndpointProfile     *EndpointProfile
+
+	// CredentialProcess is an external command which prints the AK/SK in JSON format, it is re-invoked to
+	// refresh the temporary credentials before they expire.
+	CredentialProcess string
+
+	// CredentialProvider is the source of the credentials, it is used to refresh the temporary credentials
+	// before they expire, and credentialSnapshot is the authentication arguments to retrieve them again.
+	CredentialProvider CredentialProvider
+	credentialSnapshot credentialSnapshot
+
+	// AssumeRoles is an ordered list of agencies to switch to, each hop is authorized by the
+	// temporary credentials obtained from the previous one.
+	AssumeRoles []AssumeRole
+
+	// Tracer exports the spans of the Terraform operations and API requests when the tracing is enabled,
+	// the config of each operation is a copy of the config of the provider (parent) with the traceContext.
+	Tracer       *Tracer
+	parent       *HcsConfig
+	traceContext context.Context
+
+	// ScopedProjectIDMap caches the IDs of the projects specified in the resources per region and project name,
+	// and projectScope is the project of a single operation, see ScopeResourceProject.
+	ScopedProjectIDMap map[string]string
+	projectScope       *projectScope
+
+	// AuditLogger appends a JSON line for each API request to the audit log file, it is nil if not configured.
+	AuditLogger *AuditLogger
+	// PlanCache caches the flavors and availability zones queried by the plan-time validations.
+	PlanCache *PlanCache
+	// QuotaPrecheck indicates whether to check the remaining quotas for the planned resources.
+	QuotaPrecheck bool
+
+	// Recorder records or replays the API requests in the acceptance tests, it is nil in the normal use.
+	Recorder *recorder.Recorder
+
+	// DefaultTags is the provider-level default tags which are merged into the tags of the taggable resources.
+	DefaultTags map[string]string
+	// IgnoreTags is the provider-level settings of the tags which are managed outside of Terraform.
+	IgnoreTags utils.IgnoreTagsConfig
+
 	EnableForceNew bool
 }
 
+// AssumeRole is a single hop of the assume_role chain.
+type AssumeRole struct {
+	AgencyName  string
+	DomainName  string
+	ProjectName string
+	// Duration is the validity period (in seconds) of the temporary credentials.
+	Duration int
+}
+
 // GetForceNew returns the enable_force_new that was specified in the resource.
 // If it was not set, the provider-level value is checked. The provider-level value can
 // either be set by the `enable_force_new` argument or by HCS_ENABLE_FORCE_NEW.
@@ -80,6 +145,20 @@ func (c *HcsConfig) LoadAndValidate() error {
 		return fmt.Errorf("max_retries should be a positive value")
 	}
 
+	if err := validateRetryPolicies(c); err != nil {
+		return err
+	}
+
+	if err := validateRateLimiters(c); err != nil {
+		return err
+	}
+
+	if c.EndpointProfileFile != "" {
+		if err := c.applyEndpointProfile(); err != nil {
+			return err
+		}
+	}
+
 	err := buildClient(c)
 	if err != nil {
 		return err
@@ -93,9 +172,10 @@ func (c *HcsConfig) LoadAndValidate() error {
 		return fmt.Errorf("project_name or project_id should be provided")
 	}
 
-	if c.Cloud == "" && len(c.Endpoints) <= 0 {
+	if c.Cloud == "" && len(c.Endpoints) <= 0 && c.EndpointProfile == nil {
 		return fmt.Errorf("cloud or endpoints should be provided")
 	}
+	c.setTemplatedEndpoints()
 
 	// set DomainID for IAM resource
 	if c.DomainID == "" {
@@ -124,6 +204,12 @@ func (c *HcsConfig) LoadAndValidate() error {
 	}
 	log.Printf("[DEBUG] init region and project map: %#v", c.RegionProjectIDMap)
 
+	if c.EndpointDiscovery == EndpointDiscoveryCatalog {
+		if err := c.discoverEndpoints(); err != nil {
+			log.Printf("[WARN] %s, the templated endpoints will be used", err)
+		}
+	}
+
 	if c.UserID == "" && c.Username != "" {
 		if userID, err := c.getUserIDbyName(c.Username); err == nil {
 			c.UserID = userID
@@ -135,30 +221,13 @@ func (c *HcsConfig) LoadAndValidate() error {
 	return nil
 }
 
-func retryBackoffFunc(ctx context.Context, respErr *golangsdk.ErrUnexpectedResponseCode, e error, retries uint) error {
-	minutes := int(math.Pow(2, float64(retries)))
-	if minutes > 30 { // won't wait more than 30 minutes
-		minutes = 30
-	}
-
-	log.Printf("[WARN] Received StatusTooManyRequests response code, try to sleep %d minutes", minutes)
-	sleep := time.Duration(minutes) * time.Minute
-
-	if ctx != nil {
-		select {
-		case <-time.After(sleep):
-		case <-ctx.Done():
-			return e
+func getObsEndpoint(c *HcsConfig, region string) string {
+	if _, ok := c.UserEndpoints["obs"]; !ok && c.EndpointProfile != nil {
+		if endpoint := c.EndpointProfile.Endpoint("obs", region); endpoint != "" {
+			return endpoint
 		}
-	} else {
-		//lintignore:R018
-		time.Sleep(sleep)
 	}
 
-	return nil
-}
-
-func getObsEndpoint(c *HcsConfig, region string) string {
 	if endpoint, ok := c.Endpoints["obs"]; ok {
 		// replace the region in customizing OBS endpoint
 		subparts := strings.Split(endpoint, ".")
@@ -168,51 +237,44 @@ func getObsEndpoint(c *HcsConfig, region string) string {
 		}
 		return endpoint
 	}
-	return fmt.Sprintf("https://obs.%s.%s/", region, c.Cloud)
+	return fmt.Sprintf("https://obs.%s.%s/", region, c.getCloud(region))
 }
 
 func (c *HcsConfig) ObjectStorageClientWithSignature(region string) (*obs.ObsClient, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+	accessKey, secretKey, securityToken := c.currentCredentials()
+	if accessKey == "" || secretKey == "" {
 		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
 	}
 
 	clientConfigure := obs.WithHttpClient(&c.DomainClient.HTTPClient)
 	userAgentConfigure := obs.WithUserAgent(buildObsUserAgent())
 	obsEndpoint := getObsEndpoint(c, region)
-	if c.SecurityToken != "" {
-		return obs.New(c.AccessKey, c.SecretKey, obsEndpoint,
-			obs.WithSignature("OBS"), obs.WithSecurityToken(c.SecurityToken), clientConfigure,
+	if securityToken != "" {
+		return obs.New(accessKey, secretKey, obsEndpoint,
+			obs.WithSignature("OBS"), obs.WithSecurityToken(securityToken), clientConfigure,
 			userAgentConfigure)
 	}
-	return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, obs.WithSignature("OBS"), clientConfigure, userAgentConfigure)
+	return obs.New(accessKey, secretKey, obsEndpoint, obs.WithSignature("OBS"), clientConfigure, userAgentConfigure)
 }
 
 func (c *HcsConfig) ObjectStorageClient(region string) (*obs.ObsClient, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+	if !c.isAKSKAuth() {
 		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
 	}
 
-	if !c.SecurityKeyExpiresAt.IsZero() {
-		c.SecurityKeyLock.Lock()
-		defer c.SecurityKeyLock.Unlock()
-		timeNow := time.Now().Unix()
-		expairesAtInt := c.SecurityKeyExpiresAt.Unix()
-		if timeNow+keyExpiresDuration > expairesAtInt {
-			err := c.reloadSecurityKey()
-			if err != nil {
-				return nil, err
-			}
-		}
+	if err := c.refreshExpiringCredentials(); err != nil {
+		return nil, err
 	}
 
+	accessKey, secretKey, securityToken := c.currentCredentials()
 	clientConfigure := obs.WithHttpClient(&c.DomainClient.HTTPClient)
 	userAgentConfigure := obs.WithUserAgent(buildObsUserAgent())
 	obsEndpoint := getObsEndpoint(c, region)
-	if c.SecurityToken != "" {
-		return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, obs.WithSecurityToken(c.SecurityToken), clientConfigure,
+	if securityToken != "" {
+		return obs.New(accessKey, secretKey, obsEndpoint, obs.WithSecurityToken(securityToken), clientConfigure,
 			userAgentConfigure)
 	}
-	return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, clientConfigure, userAgentConfigure)
+	return obs.New(accessKey, secretKey, obsEndpoint, clientConfigure, userAgentConfigure)
 }
 
 func buildObsUserAgent() string {
@@ -228,61 +290,71 @@ func buildObsUserAgent() string {
 // If you want to add new ServiceClient, please make sure the catalog was already in allServiceCatalog.
 // the endpoint likes https://{Name}.{Region}.myhuaweicloud.com/{Version}/{project_id}/{ResourceBase}
 func (c *HcsConfig) NewServiceClient(srv, region string) (*golangsdk.ServiceClient, error) {
-	serviceCatalog, ok := allServiceCatalog[srv]
+	serviceCatalog, ok := c.getServiceCatalog(srv, region)
 	if !ok {
-		return nil, fmt.Errorf("service type %s is invalid or not supportted", srv)
-	}
-
-	if !c.SecurityKeyExpiresAt.IsZero() {
-		c.SecurityKeyLock.Lock()
-		defer c.SecurityKeyLock.Unlock()
-		timeNow := time.Now().Unix()
-		expairesAtInt := c.SecurityKeyExpiresAt.Unix()
-		if timeNow+keyExpiresDuration > expairesAtInt {
-			err := c.reloadSecurityKey()
-			if err != nil {
-				return nil, err
-			}
-		}
+		return nil, fmt.Errorf("service type %s is invalid or not supported", srv)
+	}
+
+	if err := c.refreshExpiringCredentials(); err != nil {
+		return nil, err
 	}
 
 	client := c.HcsHwClient
 	if serviceCatalog.Admin {
 		client = c.HcsDomainClient
 	}
+	if _, ok := c.ServiceRetryPolicies[srv]; ok {
+		client = withRetryPolicy(client, c.GetRetryPolicy(srv))
+	}
+	client = c.withTraceClient(client, srv)
 
-	if endpoint, ok := c.Endpoints[srv]; ok {
-		return c.newServiceClientByEndpoint(client, srv, endpoint)
+	if endpoint, ok := c.getCustomEndpoint(srv, region); ok {
+		scopedClient, err := c.withScopedProject(client, region)
+		if err != nil {
+			return nil, err
+		}
+		return c.newServiceClientByEndpoint(scopedClient, serviceCatalog, endpoint)
 	}
-	return c.newServiceClientByName(client, serviceCatalog, region)
+	return c.newServiceClientByName(client, srv, serviceCatalog, region)
 }
 
-func (c *HcsConfig) newServiceClientByName(client *golangsdk.ProviderClient, catalog ServiceCatalog, region string) (*golangsdk.ServiceClient, error) {
+// getCustomEndpoint returns the endpoint which is specified in the provider block or loaded at LoadAndValidate.
+// The endpoint of the region in the endpoint profile is not custom, it is built in newServiceClientByName.
+func (c *HcsConfig) getCustomEndpoint(srv, region string) (string, bool) {
+	if endpoint, ok := c.UserEndpoints[srv]; ok {
+		return endpoint, true
+	}
+	if c.EndpointProfile != nil && c.EndpointProfile.Endpoint(srv, region) != "" {
+		return "", false
+	}
+
+	// the other endpoints in Endpoints are loaded from the profile, discovered or templated for the provider region
+	if region == c.Region {
+		if endpoint, ok := c.Endpoints[srv]; ok {
+			return endpoint, true
+		}
+	}
+	return c.templatedEndpoint(srv, region)
+}
+
+func (c *HcsConfig) newServiceClientByName(client *golangsdk.ProviderClient, srv string, catalog ServiceCatalog, region string) (*golangsdk.ServiceClient, error) {
 	if catalog.Name == "" {
 		return nil, fmt.Errorf("must specify the service name")
 	}
 
 	// Custom Resource-level region only supports AK/SK authentication.
 	// If set it when using non AK/SK authentication, then it must be the same as Provider-level region.
-	if region != c.Region && (c.AccessKey == "" || c.SecretKey == "") {
+	if region != c.Region && !c.isAKSKAuth() {
 		return nil, fmt.Errorf("Resource-level region must be the same as Provider-level region when using non AK/SK authentication if Resource-level region set")
 	}
 
-	c.RPLock.Lock()
-	defer c.RPLock.Unlock()
-	projectID, ok := c.RegionProjectIDMap[region]
-	if !ok {
-		// Not find in the map, then try to query and store.
-		err := c.loadUserProjects(client, region)
-		if err != nil {
-			return nil, err
-		}
-		projectID = c.RegionProjectIDMap[region]
+	projectID, err := c.getProjectID(client, region)
+	if err != nil {
+		return nil, err
 	}
 
 	// update ProjectID and region in ProviderClient
-	clone := new(golangsdk.ProviderClient)
-	*clone = *client
+	clone := cloneProviderClient(client)
 	clone.ProjectID = projectID
 	clone.AKSKAuthOptions.ProjectId = projectID
 	clone.AKSKAuthOptions.Region = region
@@ -291,12 +363,7 @@ func (c *HcsConfig) newServiceClientByName(client *golangsdk.ProviderClient, cat
 		ProviderClient: clone,
 	}
 
-	if catalog.Scope == "global" && !c.RegionClient {
-		sc.Endpoint = fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
-	} else {
-		sc.Endpoint = fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
-	}
-
+	sc.Endpoint = c.buildServiceEndpoint(srv, catalog, region)
 	sc.ResourceBase = sc.Endpoint
 	if catalog.Version != "" {
 		sc.ResourceBase = sc.ResourceBase + catalog.Version + "/"
@@ -313,12 +380,7 @@ func (c *HcsConfig) newServiceClientByName(client *golangsdk.ProviderClient, cat
 
 // newServiceClientByEndpoint returns a ServiceClient which the endpoint was initialized by customer
 // the format of customer endpoint likes https://{Name}.{Region}.xxxx.com
-func (c *HcsConfig) newServiceClientByEndpoint(client *golangsdk.ProviderClient, srv, endpoint string) (*golangsdk.ServiceClient, error) {
-	catalog, ok := allServiceCatalog[srv]
-	if !ok {
-		return nil, fmt.Errorf("service type %s is invalid or not supportted", srv)
-	}
-
+func (c *HcsConfig) newServiceClientByEndpoint(client *golangsdk.ProviderClient, catalog ServiceCatalog, endpoint string) (*golangsdk.ServiceClient, error) {
 	sc := &golangsdk.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       endpoint,
@@ -395,8 +457,12 @@ func (c *HcsConfig) getUserIDbyName(name string) (string, error) {
 	return all[0].ID, nil
 }
 
-// loadUserProjects will query the region-projectId pair and store it into RegionProjectIDMap
-func (c *HcsConfig) loadUserProjects(client *golangsdk.ProviderClient, region string) error {
+// loadUserProjects will query the region-projectId pair and store it into RegionProjectIDMap.
+// If the projectName is specified, the project is queried by the name and stored into ScopedProjectIDMap.
+func (c *HcsConfig) loadUserProjects(client *golangsdk.ProviderClient, region, projectName string) error {
+	if projectName != "" {
+		return c.loadScopedProject(client, region, projectName)
+	}
 
 	log.Printf("[DEBUG] Load project ID for region: %s", region)
 	domainID := client.DomainID
@@ -428,19 +494,48 @@ func (c *HcsConfig) loadUserProjects(client *golangsdk.ProviderClient, region st
 	return nil
 }
 
-// GetProjectID is used to get the project ID for services
-func (c *HcsConfig) GetProjectID(region string) string {
-	c.RPLock.Lock()
-	defer c.RPLock.Unlock()
+func (c *HcsConfig) loadScopedProject(client *golangsdk.ProviderClient, region, projectName string) error {
+	log.Printf("[DEBUG] Load project ID for project %s in region: %s", projectName, region)
+	opts := projects.ListOpts{
+		DomainID: client.DomainID,
+		Name:     projectName,
+	}
+	sc := new(golangsdk.ServiceClient)
+	sc.Endpoint = c.IdentityEndpoint + "/"
+	sc.ProviderClient = client
+	allPages, err := projects.List(sc, &opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("List projects failed, err=%s", err)
+	}
 
-	projectID, ok := c.RegionProjectIDMap[region]
-	if !ok {
-		// Not find in the map, then try to query and store.
-		if err := c.loadUserProjects(c.HcsDomainClient, region); err != nil {
-			log.Printf("[WARN] can not find the project ID of %s: %s", region, err)
-			return ""
+	all, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		return fmt.Errorf("Extract projects failed, err=%s", err)
+	}
+
+	for _, item := range all {
+		// the project names are unique in the domain, but the project may belong to another region
+		if !c.isRegionProject(item, region) {
+			log.Printf("[DEBUG] skip the project %s/%s which does not belong to region %s", item.Name, item.ID, region)
+			continue
+		}
+
+		if c.ScopedProjectIDMap == nil {
+			c.ScopedProjectIDMap = make(map[string]string)
 		}
-		projectID = c.RegionProjectIDMap[region]
+		log.Printf("[DEBUG] add %s/%s to scoped project map of region %s", item.Name, item.ID, region)
+		c.ScopedProjectIDMap[scopedProjectKey(region, projectName)] = item.ID
+		return nil
+	}
+	return fmt.Errorf("Wrong name or no access to the project %s in region: %s", projectName, region)
+}
+
+// GetProjectID is used to get the project ID for services, it is the project specified in the resource if set.
+func (c *HcsConfig) GetProjectID(region string) string {
+	projectID, err := c.getProjectID(c.HcsDomainClient, region)
+	if err != nil {
+		log.Printf("[WARN] can not find the project ID of %s: %s", region, err)
+		return ""
 	}
 
 	return projectID
@@ -644,6 +739,12 @@ func (c *HcsConfig) NatGatewayClient(region string) (*golangsdk.ServiceClient, e
 	return c.NewServiceClient("nat", region)
 }
 
+// NatV3Client is the client for the private NAT APIs,
+// the endpoint likes: https://nat.{region}.{cloud}/v3/{project_id}/
+func (c *HcsConfig) NatV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return c.NewServiceClient("natv3", region)
+}
+
 // ElbV2Client is the client for elb v2.0 (openstack) api
 func (c *HcsConfig) ElbV2Client(region string) (*golangsdk.ServiceClient, error) {
 	return c.NewServiceClient("elbv2", region)
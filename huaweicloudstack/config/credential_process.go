@@ -0,0 +1,108 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// credentialProcessTimeout is the maximum time to wait for the credential_process to print the credentials.
+const credentialProcessTimeout = 2 * time.Minute
+
+// credentialProcessOutput is the JSON document printed to stdout by the credential_process, for example:
+//
+//	{
+//	  "access": "xxx",
+//	  "secret": "xxx",
+//	  "securitytoken": "xxx",
+//	  "expires_at": "2024-01-01T12:00:00.000000Z"
+//	}
+//
+// The securitytoken and expires_at are optional for the permanent credentials.
+type credentialProcessOutput struct {
+	Access        string `json:"access"`
+	Secret        string `json:"secret"`
+	SecurityToken string `json:"securitytoken"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// parseCredentialProcessOutput parses and validates the output of the credential_process.
+func parseCredentialProcessOutput(output []byte) (*credentialProcessOutput, time.Time, error) {
+	var credential credentialProcessOutput
+	if err := json.Unmarshal(output, &credential); err != nil {
+		return nil, time.Time{}, fmt.Errorf("the output is not a valid JSON document: %s", err)
+	}
+
+	if credential.Access == "" || credential.Secret == "" {
+		return nil, time.Time{}, fmt.Errorf("access and secret are missing in the output")
+	}
+
+	var expiresAt time.Time
+	if credential.ExpiresAt != "" {
+		var err error
+		expiresAt, err = time.Parse(time.RFC3339, credential.ExpiresAt)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("the expires_at %q is not in RFC3339 format", credential.ExpiresAt)
+		}
+	}
+	return &credential, expiresAt, nil
+}
+
+// runCredentialProcess runs the command by the shell of the platform and returns its stdout.
+func runCredentialProcess(command string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timeout after %s", credentialProcessTimeout)
+		}
+		return nil, fmt.Errorf("%s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// getAuthConfigByProcess fetches the credentials from the credential_process, the SecurityKeyExpiresAt is set
+// if the credentials are temporary, so they are refreshed before expiring.
+func getAuthConfigByProcess(c *HcsConfig) error {
+	output, err := runCredentialProcess(c.CredentialProcess)
+	if err != nil {
+		return fmt.Errorf("Error running credential_process: %s", err)
+	}
+
+	credential, expiresAt, err := parseCredentialProcessOutput(output)
+	if err != nil {
+		return fmt.Errorf("Error parsing the output of credential_process: %s", err)
+	}
+
+	c.AccessKey, c.SecretKey, c.SecurityToken = credential.Access, credential.Secret, credential.SecurityToken
+	c.SecurityKeyExpiresAt = expiresAt
+	return nil
+}
+
+func buildClientByProcess(c *HcsConfig) error {
+	if err := getAuthConfigByProcess(c); err != nil {
+		return err
+	}
+	if !c.SecurityKeyExpiresAt.IsZero() {
+		log.Printf("[DEBUG] Successfully got credentials from credential_process, which will expire at: %s",
+			c.SecurityKeyExpiresAt)
+	}
+	return buildClientByAKSK(c)
+}
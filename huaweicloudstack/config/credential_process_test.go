@@ -0,0 +1,60 @@
+package config
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestParseCredentialProcessOutput(t *testing.T) {
+	output := []byte(`{"access":"ak","secret":"sk","securitytoken":"token","expires_at":"2024-01-01T12:00:00.000000Z"}`)
+	credential, expiresAt, err := parseCredentialProcessOutput(output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if credential.Access != "ak" || credential.Secret != "sk" || credential.SecurityToken != "token" {
+		t.Fatalf("unexpected credential: %#v", credential)
+	}
+	if !expiresAt.Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected expires_at: %s", expiresAt)
+	}
+
+	// the permanent credentials never expire
+	_, expiresAt, err = parseCredentialProcessOutput([]byte(`{"access":"ak","secret":"sk"}`))
+	if err != nil || !expiresAt.IsZero() {
+		t.Fatalf("expected permanent credentials, got expires_at %s and error %v", expiresAt, err)
+	}
+
+	invalidOutputs := []string{
+		`not a json`,
+		`{"access":"ak"}`,
+		`{"access":"ak","secret":"sk","expires_at":"tomorrow"}`,
+	}
+	for _, v := range invalidOutputs {
+		if _, _, err := parseCredentialProcessOutput([]byte(v)); err == nil {
+			t.Fatalf("expected an error for the output %s", v)
+		}
+	}
+}
+
+func TestGetAuthConfigByProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+
+	c := &HcsConfig{
+		CredentialProcess: `echo '{"access":"ak","secret":"sk","securitytoken":"token","expires_at":"2024-01-01T12:00:00Z"}'`,
+	}
+	if err := getAuthConfigByProcess(c); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if c.AccessKey != "ak" || c.SecretKey != "sk" || c.SecurityToken != "token" || c.SecurityKeyExpiresAt.IsZero() {
+		t.Fatalf("the credentials are not loaded from the credential_process: %s/%s/%s/%s",
+			c.AccessKey, c.SecretKey, c.SecurityToken, c.SecurityKeyExpiresAt)
+	}
+
+	c.CredentialProcess = "echo failed >&2; exit 1"
+	if err := getAuthConfigByProcess(c); err == nil {
+		t.Fatalf("expected an error when the credential_process fails")
+	}
+}
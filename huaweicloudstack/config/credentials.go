@@ -0,0 +1,363 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+)
+
+// CredentialProvider is the source of the credentials of the provider, such as the token, password, AK/SK,
+// credential_process or ECS metadata. The assumed roles are obtained with the credentials of the provider.
+type CredentialProvider interface {
+	// Name returns the authentication method, it is only used in the logs.
+	Name() string
+	// Retrieve fetches the credentials to the config and builds the clients with them.
+	Retrieve(c *HcsConfig) error
+	// Refreshable reports whether new credentials can be retrieved after the current ones expire.
+	Refreshable() bool
+}
+
+type credentialProvider struct {
+	name        string
+	refreshable bool
+	retrieve    func(c *HcsConfig) error
+}
+
+func (p *credentialProvider) Name() string {
+	return p.name
+}
+
+func (p *credentialProvider) Retrieve(c *HcsConfig) error {
+	return p.retrieve(c)
+}
+
+func (p *credentialProvider) Refreshable() bool {
+	return p.refreshable
+}
+
+// newCredentialProvider returns the credential provider according to the authentication arguments, the order
+// is the same as the priority of the arguments.
+func newCredentialProvider(c *HcsConfig) CredentialProvider {
+	switch {
+	case c.Token != "":
+		// the token specified by the user can not be renewed
+		return &credentialProvider{name: "token", retrieve: buildClientByToken}
+	case c.AccessKey != "" && c.SecretKey != "":
+		return &credentialProvider{name: "aksk", retrieve: buildClientByAKSK}
+	case c.Password != "" && (c.Username != "" || c.UserID != ""):
+		return &credentialProvider{name: "password", refreshable: true, retrieve: buildClientByPassword}
+	case c.CredentialProcess != "":
+		return &credentialProvider{name: "credential_process", refreshable: true, retrieve: buildClientByProcess}
+	case c.SharedConfigFile != "":
+		return &credentialProvider{name: "shared_config_file", retrieve: buildClientByConfig}
+	default:
+		return &credentialProvider{name: "ecs_metadata", refreshable: true, retrieve: buildClientByMeta}
+	}
+}
+
+// credentialSnapshot is the authentication arguments before the credentials are retrieved, they are
+// overridden by the credentials of the provider and the assumed roles.
+type credentialSnapshot struct {
+	AccessKey        string
+	SecretKey        string
+	SecurityToken    string
+	TenantID         string
+	TenantName       string
+	AssumeRoleAgency string
+	AssumeRoleDomain string
+}
+
+func (c *HcsConfig) takeCredentialSnapshot() {
+	c.credentialSnapshot = credentialSnapshot{
+		AccessKey:        c.AccessKey,
+		SecretKey:        c.SecretKey,
+		SecurityToken:    c.SecurityToken,
+		TenantID:         c.TenantID,
+		TenantName:       c.TenantName,
+		AssumeRoleAgency: c.AssumeRoleAgency,
+		AssumeRoleDomain: c.AssumeRoleDomain,
+	}
+}
+
+func (c *HcsConfig) restoreCredentialSnapshot() {
+	s := c.credentialSnapshot
+	c.AccessKey, c.SecretKey, c.SecurityToken = s.AccessKey, s.SecretKey, s.SecurityToken
+	c.TenantID, c.TenantName = s.TenantID, s.TenantName
+	c.AssumeRoleAgency, c.AssumeRoleDomain = s.AssumeRoleAgency, s.AssumeRoleDomain
+	c.SecurityKeyExpiresAt = time.Time{}
+}
+
+// isCredentialRefreshable checks whether the credentials in use can be refreshed. The temporary credentials of
+// the assumed roles can always be refreshed by assuming the roles again.
+func (c *HcsConfig) isCredentialRefreshable() bool {
+	return c.CredentialProvider != nil && (c.CredentialProvider.Refreshable() || c.AssumeRoleAgency != "")
+}
+
+// refreshCredentials retrieves new credentials from the credential provider and assumes the roles again with a
+// copy of the config, then only the credentials are swapped into the config. The clients are not rebuilt, the
+// requests are re-signed with the new credentials by the CredentialRoundTripper, and the project IDs queried by
+// the copy are merged into the config. The caller must hold the SecurityKeyLock.
+func (c *HcsConfig) refreshCredentials() error {
+	if !c.isCredentialRefreshable() {
+		return fmt.Errorf("the credentials of %s can not be refreshed", c.credentialProviderName())
+	}
+
+	refreshed := c.newRefreshConfig()
+	if err := c.CredentialProvider.Retrieve(refreshed); err != nil {
+		return fmt.Errorf("Error refreshing the credentials of %s: %s", c.CredentialProvider.Name(), err)
+	}
+
+	if refreshed.AssumeRoleAgency != "" {
+		if err := buildClientByAgency(refreshed); err != nil {
+			return fmt.Errorf("Error refreshing the credentials of the assumed role: %s", err)
+		}
+	}
+
+	c.AccessKey, c.SecretKey, c.SecurityToken = refreshed.AccessKey, refreshed.SecretKey, refreshed.SecurityToken
+	c.SecurityKeyExpiresAt = refreshed.SecurityKeyExpiresAt
+	c.mergeProjectIDs(refreshed)
+
+	if c.SecurityKeyExpiresAt.IsZero() {
+		log.Printf("[DEBUG] Successfully refreshed the credentials of %s", c.CredentialProvider.Name())
+	} else {
+		log.Printf("[DEBUG] Successfully refreshed the credentials of %s, which will expire at: %s",
+			c.CredentialProvider.Name(), c.SecurityKeyExpiresAt)
+	}
+	return nil
+}
+
+// newRefreshConfig returns a copy of the config with the authentication arguments before the credentials were
+// retrieved. It has its own locks and project IDs, so the clients and project IDs built by it are not shared with
+// the config in use. The caller must hold the SecurityKeyLock.
+func (c *HcsConfig) newRefreshConfig() *HcsConfig {
+	refreshed := *c
+	refreshed.parent = nil
+	refreshed.Metadata = &refreshed
+	refreshed.SecurityKeyLock = new(sync.Mutex)
+	refreshed.RPLock = new(sync.Mutex)
+
+	c.RPLock.Lock()
+	refreshed.RegionProjectIDMap = copyStringMap(c.RegionProjectIDMap)
+	refreshed.ScopedProjectIDMap = copyStringMap(c.ScopedProjectIDMap)
+	c.RPLock.Unlock()
+
+	refreshed.restoreCredentialSnapshot()
+	return &refreshed
+}
+
+// mergeProjectIDs adds the project IDs queried by the refreshed config, the existing ones are kept.
+func (c *HcsConfig) mergeProjectIDs(refreshed *HcsConfig) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+
+	if c.RegionProjectIDMap == nil {
+		c.RegionProjectIDMap = make(map[string]string)
+	}
+	for region, projectID := range refreshed.RegionProjectIDMap {
+		if _, ok := c.RegionProjectIDMap[region]; !ok {
+			c.RegionProjectIDMap[region] = projectID
+		}
+	}
+
+	if c.ScopedProjectIDMap == nil {
+		c.ScopedProjectIDMap = make(map[string]string)
+	}
+	for key, projectID := range refreshed.ScopedProjectIDMap {
+		if _, ok := c.ScopedProjectIDMap[key]; !ok {
+			c.ScopedProjectIDMap[key] = projectID
+		}
+	}
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// root returns the config of the provider, the configs of the operations are copies of it which share its
+// credentials.
+func (c *HcsConfig) root() *HcsConfig {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
+}
+
+// refreshExpiringCredentials refreshes the temporary credentials if they expire in keyExpiresDuration seconds.
+// The credentials are refreshed by the config of the provider and shared by all operations.
+func (c *HcsConfig) refreshExpiringCredentials() error {
+	root := c.root()
+	root.SecurityKeyLock.Lock()
+	defer root.SecurityKeyLock.Unlock()
+
+	if root.SecurityKeyExpiresAt.IsZero() || time.Now().Unix()+keyExpiresDuration <= root.SecurityKeyExpiresAt.Unix() {
+		return nil
+	}
+	return root.refreshCredentials()
+}
+
+// refreshRejectedCredentials refreshes the credentials after the access key is rejected with 401,
+// it is skipped if the credentials have been refreshed by another request in the meantime.
+func (c *HcsConfig) refreshRejectedCredentials(rejectedAccessKey string) error {
+	c = c.root()
+	c.SecurityKeyLock.Lock()
+	defer c.SecurityKeyLock.Unlock()
+
+	if c.AccessKey != rejectedAccessKey {
+		return nil
+	}
+	log.Printf("[DEBUG] the access key %s is rejected, refreshing the credentials of %s",
+		maskAccessKey(rejectedAccessKey), c.credentialProviderName())
+	return c.refreshCredentials()
+}
+
+func (c *HcsConfig) credentialProviderName() string {
+	if c.CredentialProvider == nil {
+		return "the provider"
+	}
+	return c.CredentialProvider.Name()
+}
+
+// currentCredentials returns the credentials in use, it waits until the refreshing is finished.
+// The credentials are only read by this method once the clients are built, since they are swapped by the refreshing.
+func (c *HcsConfig) currentCredentials() (accessKey, secretKey, securityToken string) {
+	c = c.root()
+	if c.SecurityKeyLock != nil {
+		c.SecurityKeyLock.Lock()
+		defer c.SecurityKeyLock.Unlock()
+	}
+
+	return c.AccessKey, c.SecretKey, c.SecurityToken
+}
+
+// isAKSKAuth checks whether the requests are signed by AK/SK.
+func (c *HcsConfig) isAKSKAuth() bool {
+	accessKey, secretKey, _ := c.currentCredentials()
+	return accessKey != "" && secretKey != ""
+}
+
+// isIdentityHost checks whether the request is sent to IAM, the authentication requests are never refreshed.
+func (c *HcsConfig) isIdentityHost(host string) bool {
+	if parsed, err := url.Parse(c.IdentityEndpoint); err == nil && parsed.Host == host {
+		return true
+	}
+	return c.isServiceHost("iam", host)
+}
+
+// CredentialRoundTripper satisfies the http.RoundTripper interface and is used to keep the temporary credentials
+// valid during the long-running operations. The requests signed by AK/SK are re-signed with the refreshed
+// credentials, and they are sent once more if the credentials are rejected with 401.
+// The requests authorized by token are re-authenticated by the ReauthFunc of the ProviderClient.
+type CredentialRoundTripper struct {
+	Rt     http.RoundTripper
+	Config *HcsConfig
+}
+
+// RoundTrip refreshes the expiring credentials and performs a round-trip HTTP request.
+func (crt *CredentialRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	c := crt.Config
+	signedAccessKey, signedRegion := parseSignedCredential(request.Header.Get("Authorization"))
+	if signedAccessKey == "" || !c.isCredentialRefreshable() || c.isIdentityHost(request.URL.Host) {
+		return crt.Rt.RoundTrip(request)
+	}
+
+	if err := c.refreshExpiringCredentials(); err != nil {
+		return nil, err
+	}
+
+	signedRequest, err := c.resignRequest(request, signedAccessKey, signedRegion)
+	if err != nil {
+		return nil, err
+	}
+	response, err := crt.Rt.RoundTrip(signedRequest)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+	if request.Body != nil && request.GetBody == nil {
+		// the body has been consumed, the request can not be sent again
+		return response, nil
+	}
+
+	rejectedAccessKey, _ := parseSignedCredential(signedRequest.Header.Get("Authorization"))
+	if err := c.refreshRejectedCredentials(rejectedAccessKey); err != nil {
+		log.Printf("[WARN] %s", err)
+		return response, nil
+	}
+	response.Body.Close()
+
+	retryRequest, err := c.resignRequest(request, rejectedAccessKey, signedRegion)
+	if err != nil {
+		return nil, err
+	}
+	return crt.Rt.RoundTrip(retryRequest)
+}
+
+// resignRequest returns a copy of the request which is signed with the current credentials,
+// the request is returned as it is if it has been signed with the current access key.
+func (c *HcsConfig) resignRequest(request *http.Request, signedAccessKey, region string) (*http.Request, error) {
+	accessKey, secretKey, securityToken := c.currentCredentials()
+	if accessKey == signedAccessKey {
+		return request, nil
+	}
+
+	signedRequest := request.Clone(request.Context())
+	if request.Body != nil {
+		if request.GetBody == nil {
+			return request, nil
+		}
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		signedRequest.Body = body
+	}
+
+	if securityToken != "" {
+		signedRequest.Header.Set("X-Security-Token", securityToken)
+	} else {
+		signedRequest.Header.Del("X-Security-Token")
+	}
+	golangsdk.ReSign(signedRequest, golangsdk.SignOptions{
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		RegionName: region,
+	})
+	return signedRequest, nil
+}
+
+// parseSignedCredential parses the access key and region from the Authorization header, which likes
+// "SDK-HMAC-SHA256 Credential={ak}/{date}/{region}/{service}/sdk_request, SignedHeaders=..., Signature=...".
+func parseSignedCredential(authorization string) (accessKey, region string) {
+	if !strings.HasPrefix(authorization, golangsdk.SignAlgorithmHMACSHA256+" ") {
+		return "", ""
+	}
+
+	for _, item := range strings.Split(strings.TrimPrefix(authorization, golangsdk.SignAlgorithmHMACSHA256+" "), ",") {
+		item = strings.TrimSpace(item)
+		if !strings.HasPrefix(item, "Credential=") {
+			continue
+		}
+		parts := strings.Split(strings.TrimPrefix(item, "Credential="), "/")
+		if len(parts) > 2 {
+			return parts[0], parts[2]
+		}
+		return parts[0], ""
+	}
+	return "", ""
+}
+
+func maskAccessKey(accessKey string) string {
+	if len(accessKey) <= 4 {
+		return "***"
+	}
+	return accessKey[:4] + "***"
+}
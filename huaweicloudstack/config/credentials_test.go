@@ -0,0 +1,203 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestParseSignedCredential(t *testing.T) {
+	accessKey, region := parseSignedCredential("SDK-HMAC-SHA256 Credential=AKTEST/20240101/region-1/ecs/sdk_request, " +
+		"SignedHeaders=host;x-sdk-date, Signature=abc")
+	th.AssertEquals(t, "AKTEST", accessKey)
+	th.AssertEquals(t, "region-1", region)
+
+	accessKey, _ = parseSignedCredential("Bearer token")
+	th.AssertEquals(t, "", accessKey)
+}
+
+func TestCredentialRoundTripper(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accessKey, _ := parseSignedCredential(r.Header.Get("Authorization"))
+		received = append(received, accessKey+":"+r.Header.Get("X-Security-Token"))
+		if accessKey != "AK-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var refreshed int
+	cfg := &HcsConfig{
+		CredentialProvider: &credentialProvider{
+			name:        "test",
+			refreshable: true,
+			retrieve: func(c *HcsConfig) error {
+				refreshed++
+				c.AccessKey, c.SecretKey, c.SecurityToken = "AK-2", "SK-2", "token-2"
+				return nil
+			},
+		},
+	}
+	cfg.AccessKey, cfg.SecretKey, cfg.SecurityToken = "AK-1", "SK-1", "token-1"
+	cfg.SecurityKeyLock = new(sync.Mutex)
+	cfg.RPLock = new(sync.Mutex)
+	cfg.takeCredentialSnapshot()
+
+	request, err := http.NewRequest("POST", server.URL+"/v1/servers", strings.NewReader(`{"name":"test"}`))
+	th.AssertNoErr(t, err)
+	golangsdk.Sign(request, golangsdk.SignOptions{AccessKey: "AK-1", SecretKey: "SK-1", RegionName: "region-1"})
+	request.Header.Set("X-Security-Token", "token-1")
+
+	client := http.Client{
+		Transport: &CredentialRoundTripper{
+			Rt:     http.DefaultTransport,
+			Config: cfg,
+		},
+	}
+	response, err := client.Do(request)
+	th.AssertNoErr(t, err)
+	defer response.Body.Close()
+
+	th.AssertEquals(t, http.StatusOK, response.StatusCode)
+	th.AssertEquals(t, 1, refreshed)
+	th.AssertDeepEquals(t, []string{"AK-1:token-1", "AK-2:token-2"}, received)
+
+	// the requests signed with the stale access key are re-signed without refreshing again
+	request, err = http.NewRequest("GET", server.URL+"/v1/servers", nil)
+	th.AssertNoErr(t, err)
+	golangsdk.Sign(request, golangsdk.SignOptions{AccessKey: "AK-1", SecretKey: "SK-1", RegionName: "region-1"})
+
+	response, err = client.Do(request)
+	th.AssertNoErr(t, err)
+	defer response.Body.Close()
+
+	th.AssertEquals(t, http.StatusOK, response.StatusCode)
+	th.AssertEquals(t, 1, refreshed)
+}
+
+func TestCredentialRoundTripper_concurrentRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accessKey, _ := parseSignedCredential(r.Header.Get("Authorization"))
+		if accessKey == "AK-0" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var refreshed int32
+	cfg := &HcsConfig{
+		CredentialProvider: &credentialProvider{
+			name:        "test",
+			refreshable: true,
+			retrieve: func(c *HcsConfig) error {
+				n := atomic.AddInt32(&refreshed, 1)
+				// the new credentials are always expiring, so they are refreshed by every request
+				c.AccessKey, c.SecretKey = fmt.Sprintf("AK-%d", n), fmt.Sprintf("SK-%d", n)
+				c.SecurityKeyExpiresAt = time.Now().Add(time.Second)
+				c.RegionProjectIDMap["region-1"] = fmt.Sprintf("project-1-%d", n)
+				c.RegionProjectIDMap["region-3"] = "project-3"
+				return nil
+			},
+		},
+		ScopedProjectIDMap: map[string]string{},
+	}
+	cfg.Region = "region-1"
+	cfg.AccessKey, cfg.SecretKey = "AK-0", "SK-0"
+	cfg.RegionProjectIDMap = map[string]string{"region-1": "project-1", "region-2": "project-2"}
+	cfg.SecurityKeyLock = new(sync.Mutex)
+	cfg.RPLock = new(sync.Mutex)
+	cfg.takeCredentialSnapshot()
+	cfg.SecurityKeyExpiresAt = time.Now()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// the configs of the operations share the credentials of the provider
+			c := cfg
+			if i%2 == 0 {
+				c = cfg.withProjectScope("project-id", "")
+			}
+			client := http.Client{
+				Transport: &CredentialRoundTripper{
+					Rt:     http.DefaultTransport,
+					Config: c,
+				},
+			}
+
+			for j := 0; j < 10; j++ {
+				request, err := http.NewRequest("GET", server.URL+"/v1/servers", nil)
+				if err != nil {
+					errs <- err
+					return
+				}
+				accessKey, secretKey, _ := c.currentCredentials()
+				golangsdk.Sign(request, golangsdk.SignOptions{AccessKey: accessKey, SecretKey: secretKey, RegionName: "region-2"})
+				response, err := client.Do(request)
+				if err != nil {
+					errs <- err
+					return
+				}
+				response.Body.Close()
+				if response.StatusCode != http.StatusOK {
+					errs <- fmt.Errorf("unexpected status code: %d", response.StatusCode)
+				}
+
+				if _, err := c.getProjectID(nil, "region-2"); err != nil {
+					errs <- err
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	th.AssertEquals(t, true, atomic.LoadInt32(&refreshed) > 1)
+	// the project IDs of the other regions are kept and the project IDs queried by the refreshing are merged
+	th.AssertDeepEquals(t, map[string]string{
+		"region-1": "project-1",
+		"region-2": "project-2",
+		"region-3": "project-3",
+	}, cfg.RegionProjectIDMap)
+}
+
+func TestCloneProviderClient_reauthenticate(t *testing.T) {
+	var reauthenticated int
+	client := &golangsdk.ProviderClient{TokenID: "token-1"}
+	client.UseTokenLock()
+	client.ReauthFunc = func() error {
+		reauthenticated++
+		client.TokenID = fmt.Sprintf("token-%d", reauthenticated+1)
+		return nil
+	}
+
+	first, second := cloneProviderClient(client), cloneProviderClient(client)
+	th.AssertNoErr(t, first.Reauthenticate(first.Token()))
+	th.AssertEquals(t, 1, reauthenticated)
+	th.AssertEquals(t, "token-2", first.Token())
+	th.AssertEquals(t, "token-2", client.Token())
+
+	// the token renewed by another copy is used without re-authenticating again
+	th.AssertEquals(t, "token-1", second.Token())
+	th.AssertNoErr(t, second.Reauthenticate(second.Token()))
+	th.AssertEquals(t, 1, reauthenticated)
+	th.AssertEquals(t, "token-2", second.Token())
+}
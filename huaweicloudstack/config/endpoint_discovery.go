@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/tokens"
+)
+
+const (
+	// EndpointDiscoveryCatalog means the endpoints are discovered from the IAM service catalog.
+	EndpointDiscoveryCatalog = "catalog"
+	// EndpointDiscoveryTemplate means the endpoints are built by the name of the service catalog.
+	EndpointDiscoveryTemplate = "template"
+)
+
+// catalogTypeKeys is a map of the standard OpenStack service types in IAM catalog and the primary catalog keys.
+// The service types which are not in this map will be matched with the catalog keys directly.
+var catalogTypeKeys = map[string]string{
+	"compute":       "ecs",
+	"volume":        "evs",
+	"volumev2":      "evs",
+	"volumev3":      "evs",
+	"network":       "vpc",
+	"image":         "ims",
+	"object-store":  "obs",
+	"load-balancer": "elb",
+	"key-manager":   "kms",
+	"orchestration": "rts",
+}
+
+// discoverEndpoints queries the service catalog from IAM and fills the endpoints which are not specified
+// in the provider block or the endpoint profile, the templated endpoints will be used for the services missing in the catalog.
+func (c *HcsConfig) discoverEndpoints() error {
+	sc := new(golangsdk.ServiceClient)
+	sc.Endpoint = c.IdentityEndpoint + "/"
+	sc.ProviderClient = c.HcsHwClient
+
+	catalog, err := tokens.GetCatalog(sc).ExtractServiceCatalog()
+	if err != nil {
+		return fmt.Errorf("error querying the service catalog from IAM: %s", err)
+	}
+
+	discovered := parseCatalogEndpoints(catalog, c.Region)
+	for key, endpoint := range discovered {
+		if _, ok := c.UserEndpoints[key]; ok {
+			continue
+		}
+		if c.EndpointProfile != nil && c.EndpointProfile.Endpoint(key, c.Region) != "" {
+			continue
+		}
+		c.Endpoints[key] = endpoint
+	}
+
+	keys := make([]string, 0, len(discovered))
+	for key := range discovered {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	log.Printf("[DEBUG] discovered %d endpoints from the service catalog: %v", len(keys), keys)
+	return nil
+}
+
+// parseCatalogEndpoints returns the public endpoints of the region in the service catalog,
+// the key of the result is the catalog key of the provider, and the value likes https://{host}/
+func parseCatalogEndpoints(catalog *tokens.ServiceCatalog, region string) map[string]string {
+	result := make(map[string]string)
+	if catalog == nil {
+		return result
+	}
+
+	for _, entry := range catalog.Entries {
+		key := catalogEntryKey(entry)
+		if key == "" {
+			log.Printf("[DEBUG] ignore the unknown service %s (%s) in the service catalog", entry.Name, entry.Type)
+			continue
+		}
+
+		endpoint := regionalPublicEndpoint(entry.Endpoints, region)
+		if endpoint == "" {
+			continue
+		}
+
+		result[key] = endpoint
+		for _, derivedKey := range GetServiceDerivedCatalogKeys(key) {
+			result[derivedKey] = endpoint
+		}
+	}
+	return result
+}
+
+func catalogEntryKey(entry tokens.CatalogEntry) string {
+	if key, ok := catalogTypeKeys[entry.Type]; ok {
+		return key
+	}
+
+	for _, name := range []string{entry.Type, entry.Name} {
+		if _, ok := allServiceCatalog[name]; ok && name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// regionalPublicEndpoint returns the base URL of the public endpoint, the endpoint of the region is
+// prior to the endpoint without region.
+func regionalPublicEndpoint(endpoints []tokens.Endpoint, region string) string {
+	var result string
+	for _, ep := range endpoints {
+		if ep.Interface != "public" {
+			continue
+		}
+
+		if ep.Region == region {
+			result = ep.URL
+			break
+		}
+		if ep.Region == "" || ep.Region == "*" {
+			result = ep.URL
+		}
+	}
+
+	if result == "" {
+		return ""
+	}
+
+	// the URL in catalog may contain the version and project ID, such as https://{host}/v2.1/$(tenant_id)s
+	parsed, err := url.Parse(result)
+	if err != nil || parsed.Host == "" {
+		log.Printf("[WARN] invalid endpoint in the service catalog: %s", result)
+		return ""
+	}
+	return fmt.Sprintf("%s://%s/", parsed.Scheme, parsed.Host)
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/tokens"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestParseCatalogEndpoints(t *testing.T) {
+	catalog := &tokens.ServiceCatalog{
+		Entries: []tokens.CatalogEntry{
+			{
+				Name: "nova",
+				Type: "compute",
+				Endpoints: []tokens.Endpoint{
+					{Interface: "internal", Region: "region-0", URL: "https://ecs.internal.region-0.mycloud.com/v2.1"},
+					{Interface: "public", Region: "region-0", URL: "https://ecs-ops.region-0.mycloud.com/v2.1/$(tenant_id)s"},
+					{Interface: "public", Region: "region-1", URL: "https://ecs-ops.region-1.mycloud.com/v2.1/$(tenant_id)s"},
+				},
+			},
+			{
+				Name: "rds",
+				Type: "rds",
+				Endpoints: []tokens.Endpoint{
+					{Interface: "public", Region: "*", URL: "https://rds-api.mycloud.com"},
+				},
+			},
+			{
+				Name: "unknown",
+				Type: "unknown",
+				Endpoints: []tokens.Endpoint{
+					{Interface: "public", Region: "region-0", URL: "https://unknown.region-0.mycloud.com"},
+				},
+			},
+		},
+	}
+
+	endpoints := parseCatalogEndpoints(catalog, "region-0")
+	th.AssertEquals(t, "https://ecs-ops.region-0.mycloud.com/", endpoints["ecs"])
+	th.AssertEquals(t, "https://ecs-ops.region-0.mycloud.com/", endpoints["ecsv21"])
+	th.AssertEquals(t, "https://rds-api.mycloud.com/", endpoints["rds"])
+	th.AssertEquals(t, "https://rds-api.mycloud.com/", endpoints["rdsv1"])
+	_, ok := endpoints["unknown"]
+	th.AssertEquals(t, false, ok)
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/pathorcontents"
+)
+
+// EndpointProfile describes the endpoints of an HCS installation, it is loaded from the endpoint_profile_file.
+// The document can be written in JSON or YAML, for example:
+//
+//	ca_bundle: /etc/hcs/root-ca.pem
+//	services:
+//	  ecs:
+//	    version: v2.1
+//	regions:
+//	  region-1:
+//	    cloud: hcs.example.com
+//	    ca_bundle: /etc/hcs/region-1-ca.pem
+//	    services:
+//	      ecs:
+//	        endpoint: https://ecs-api.region-1.example.com
+//	      cfw:
+//	        host: cfw.region-1.example.com
+//	        without_project_id: true
+//
+// The settings of a region take precedence over the top-level settings, and both of them are layered
+// on top of the built-in service catalogs.
+type EndpointProfile struct {
+	CABundle string                           `yaml:"ca_bundle"`
+	Services map[string]ServiceProfile        `yaml:"services"`
+	Regions  map[string]RegionEndpointProfile `yaml:"regions"`
+}
+
+// RegionEndpointProfile is the endpoint settings of a region.
+type RegionEndpointProfile struct {
+	// Cloud is the domain suffix used to build the endpoints of the region, such as {name}.{region}.{cloud}.
+	Cloud    string                    `yaml:"cloud"`
+	CABundle string                    `yaml:"ca_bundle"`
+	Services map[string]ServiceProfile `yaml:"services"`
+}
+
+// ServiceProfile overrides the built-in ServiceCatalog of a service, the nil or empty fields are not overridden.
+type ServiceProfile struct {
+	// Endpoint is the full base URL of the service, such as https://ecs.region-1.example.com/
+	Endpoint string `yaml:"endpoint"`
+	// Host is the hostname of the service, the scheme is always https.
+	Host             string  `yaml:"host"`
+	Version          *string `yaml:"version"`
+	ResourceBase     *string `yaml:"resource_base"`
+	WithOutProjectID *bool   `yaml:"without_project_id"`
+	Admin            *bool   `yaml:"admin"`
+}
+
+// loadEndpointProfile reads the endpoint profile from the path or the contents, and validates it.
+func loadEndpointProfile(pathOrContents string) (*EndpointProfile, error) {
+	contents, _, err := pathorcontents.Read(pathOrContents)
+	if err != nil {
+		return nil, fmt.Errorf("error reading endpoint_profile_file: %s", err)
+	}
+
+	// JSON is a subset of YAML, so both of them can be decoded by the YAML decoder
+	var profile EndpointProfile
+	decoder := yaml.NewDecoder(strings.NewReader(contents))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profile); err != nil {
+		return nil, fmt.Errorf("error parsing endpoint_profile_file: %s", err)
+	}
+
+	if err := profile.validate(); err != nil {
+		return nil, fmt.Errorf("invalid endpoint_profile_file: %s", err)
+	}
+	return &profile, nil
+}
+
+func (p *EndpointProfile) validate() error {
+	if err := validateServiceProfiles(p.Services, "services"); err != nil {
+		return err
+	}
+	if _, err := readCABundle(p.CABundle); err != nil {
+		return err
+	}
+
+	for region, regionProfile := range p.Regions {
+		if region == "" {
+			return fmt.Errorf("the region name can not be empty")
+		}
+		if err := validateServiceProfiles(regionProfile.Services, fmt.Sprintf("regions.%s.services", region)); err != nil {
+			return err
+		}
+		if _, err := readCABundle(regionProfile.CABundle); err != nil {
+			return fmt.Errorf("regions.%s: %s", region, err)
+		}
+	}
+	return nil
+}
+
+func validateServiceProfiles(services map[string]ServiceProfile, path string) error {
+	for srv, srvProfile := range services {
+		if _, ok := allServiceCatalog[srv]; !ok {
+			return fmt.Errorf("the service %s in %s is invalid or not supported", srv, path)
+		}
+		if srvProfile.Endpoint != "" && srvProfile.Host != "" {
+			return fmt.Errorf("only one of endpoint and host can be specified for %s.%s", path, srv)
+		}
+		if srvProfile.Endpoint != "" {
+			parsed, err := url.Parse(srvProfile.Endpoint)
+			if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+				return fmt.Errorf("the endpoint of %s.%s must be a valid HTTP(S) URL, got %q",
+					path, srv, srvProfile.Endpoint)
+			}
+		}
+		if strings.Contains(srvProfile.Host, "/") {
+			return fmt.Errorf("the host of %s.%s should not contain the scheme or path, got %q",
+				path, srv, srvProfile.Host)
+		}
+	}
+	return nil
+}
+
+// readCABundle reads the PEM encoded certificates from the path or the contents.
+func readCABundle(pathOrContents string) ([]byte, error) {
+	if pathOrContents == "" {
+		return nil, nil
+	}
+
+	contents, _, err := pathorcontents.Read(pathOrContents)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ca_bundle: %s", err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(contents)) {
+		return nil, fmt.Errorf("no valid PEM certificate is found in ca_bundle %s", pathOrContents)
+	}
+	return []byte(contents), nil
+}
+
+// CABundles returns the certificates which should be trusted by the region.
+func (p *EndpointProfile) CABundles(region string) []byte {
+	var bundles [][]byte
+	for _, pathOrContents := range []string{p.CABundle, p.Regions[region].CABundle} {
+		// the CA bundles have been validated when loading the profile
+		if bundle, _ := readCABundle(pathOrContents); len(bundle) > 0 {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bytes.Join(bundles, []byte("\n"))
+}
+
+// Cloud returns the domain suffix of the region, it is empty if not specified in the profile.
+func (p *EndpointProfile) Cloud(region string) string {
+	return p.Regions[region].Cloud
+}
+
+// ServiceCatalog returns the service catalog of the region which is overridden by the profile.
+func (p *EndpointProfile) ServiceCatalog(srv, region string, catalog ServiceCatalog) ServiceCatalog {
+	for _, srvProfile := range p.serviceProfiles(srv, region) {
+		if srvProfile.Version != nil {
+			catalog.Version = *srvProfile.Version
+		}
+		if srvProfile.ResourceBase != nil {
+			catalog.ResourceBase = *srvProfile.ResourceBase
+		}
+		if srvProfile.WithOutProjectID != nil {
+			catalog.WithOutProjectID = *srvProfile.WithOutProjectID
+		}
+		if srvProfile.Admin != nil {
+			catalog.Admin = *srvProfile.Admin
+		}
+	}
+	return catalog
+}
+
+// Endpoint returns the endpoint of the service in the region, the format likes https://{host}/
+// An empty string is returned if neither endpoint nor host is specified in the profile.
+func (p *EndpointProfile) Endpoint(srv, region string) string {
+	var endpoint string
+	for _, srvProfile := range p.serviceProfiles(srv, region) {
+		if srvProfile.Endpoint != "" {
+			endpoint = srvProfile.Endpoint
+		} else if srvProfile.Host != "" {
+			endpoint = fmt.Sprintf("https://%s/", srvProfile.Host)
+		}
+	}
+
+	if endpoint != "" && !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	return endpoint
+}
+
+// serviceProfiles returns the top-level and regional profiles of the service, the latter is prior to the former.
+func (p *EndpointProfile) serviceProfiles(srv, region string) []ServiceProfile {
+	profiles := make([]ServiceProfile, 0, 2)
+	if srvProfile, ok := p.Services[srv]; ok {
+		profiles = append(profiles, srvProfile)
+	}
+	if srvProfile, ok := p.Regions[region].Services[srv]; ok {
+		profiles = append(profiles, srvProfile)
+	}
+	return profiles
+}
+
+// applyEndpointProfile loads the endpoint profile and fills the endpoints of the provider region
+// which are not specified in the provider block. The Endpoints are shared by all regions in the upstream clients,
+// the in-repo clients get the endpoints of the other regions from the profile directly, see getCustomEndpoint.
+func (c *HcsConfig) applyEndpointProfile() error {
+	profile, err := loadEndpointProfile(c.EndpointProfileFile)
+	if err != nil {
+		return err
+	}
+	c.EndpointProfile = profile
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	for srv := range allServiceCatalog {
+		if _, ok := c.UserEndpoints[srv]; ok {
+			continue
+		}
+		if endpoint := profile.Endpoint(srv, c.Region); endpoint != "" {
+			c.Endpoints[srv] = endpoint
+		}
+	}
+
+	log.Printf("[DEBUG] the endpoint profile %s is loaded", c.EndpointProfileFile)
+	return nil
+}
+
+// getServiceCatalog returns the service catalog which is overridden by the endpoint profile.
+func (c *HcsConfig) getServiceCatalog(srv, region string) (ServiceCatalog, bool) {
+	catalog, ok := allServiceCatalog[srv]
+	if !ok || c.EndpointProfile == nil {
+		return catalog, ok
+	}
+	return c.EndpointProfile.ServiceCatalog(srv, region, catalog), true
+}
+
+// getCloud returns the domain suffix of the region.
+func (c *HcsConfig) getCloud(region string) string {
+	if c.EndpointProfile != nil {
+		if cloud := c.EndpointProfile.Cloud(region); cloud != "" {
+			return cloud
+		}
+	}
+	return c.Cloud
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"testing"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+const testEndpointProfile = `
+services:
+  ecs:
+    version: v2.1
+regions:
+  region-1:
+    cloud: hcs.example.com
+    services:
+      ecs:
+        endpoint: https://ecs-api.region-1.example.com
+      cfw:
+        host: cfw.region-1.example.com
+        without_project_id: true
+`
+
+func TestLoadEndpointProfile(t *testing.T) {
+	profile, err := loadEndpointProfile(testEndpointProfile)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "https://ecs-api.region-1.example.com/", profile.Endpoint("ecs", "region-1"))
+	th.AssertEquals(t, "https://cfw.region-1.example.com/", profile.Endpoint("cfw", "region-1"))
+	th.AssertEquals(t, "", profile.Endpoint("ecs", "region-2"))
+	th.AssertEquals(t, "hcs.example.com", profile.Cloud("region-1"))
+
+	catalog := profile.ServiceCatalog("ecs", "region-2", allServiceCatalog["ecs"])
+	th.AssertEquals(t, "v2.1", catalog.Version)
+	catalog = profile.ServiceCatalog("cfw", "region-1", allServiceCatalog["cfw"])
+	th.AssertEquals(t, true, catalog.WithOutProjectID)
+
+	// the JSON document is also supported
+	profile, err = loadEndpointProfile(`{"regions": {"region-1": {"services": {"vpc": {"host": "vpc.example.com"}}}}}`)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "https://vpc.example.com/", profile.Endpoint("vpc", "region-1"))
+}
+
+func TestLoadEndpointProfile_invalid(t *testing.T) {
+	invalidProfiles := []string{
+		`{"services": {"unknown": {"host": "unknown.example.com"}}}`,
+		`{"services": {"ecs": {"endpoint": "ecs.example.com"}}}`,
+		`{"services": {"ecs": {"host": "https://ecs.example.com"}}}`,
+		`{"services": {"ecs": {"host": "ecs.example.com", "endpoint": "https://ecs.example.com"}}}`,
+		`{"services": {"ecs": {"without_project": true}}}`,
+		`{"ca_bundle": "invalid certificate"}`,
+	}
+
+	for _, contents := range invalidProfiles {
+		if _, err := loadEndpointProfile(contents); err == nil {
+			t.Fatalf("the endpoint profile %s should be invalid", contents)
+		}
+	}
+}
+
+func TestGetServiceEndpoint_withProfile(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.Region = "region-1"
+	cfg.Cloud = "example.com"
+	cfg.Endpoints = map[string]string{
+		"ecs": "https://ecs-custom.example.com/",
+	}
+	cfg.UserEndpoints = map[string]string{
+		"ecs": "https://ecs-custom.example.com/",
+	}
+	cfg.EndpointProfileFile = testEndpointProfile
+	th.AssertNoErr(t, cfg.applyEndpointProfile())
+
+	// the endpoints in the provider block take precedence over the profile
+	th.AssertEquals(t, "https://ecs-custom.example.com/", GetServiceEndpoint(cfg, "ecs", "region-1"))
+	th.AssertEquals(t, "https://cfw.region-1.example.com/", GetServiceEndpoint(cfg, "cfw", "region-1"))
+	th.AssertEquals(t, "https://vpc.region-1.hcs.example.com/", GetServiceEndpoint(cfg, "vpc", "region-1"))
+	th.AssertEquals(t, "https://vpc.region-2.example.com/", GetServiceEndpoint(cfg, "vpc", "region-2"))
+}
+
+func TestGetServiceEndpoint_profileRegions(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.Region = "region-1"
+	cfg.Cloud = "example.com"
+	cfg.EndpointProfileFile = testEndpointProfile
+	th.AssertNoErr(t, cfg.applyEndpointProfile())
+	cfg.setTemplatedEndpoints()
+
+	// the profile endpoints of the provider region are not used by the other regions
+	th.AssertEquals(t, "https://ecs-api.region-1.example.com/", GetServiceEndpoint(cfg, "ecs", "region-1"))
+	th.AssertEquals(t, "https://ecs.region-2.example.com/", GetServiceEndpoint(cfg, "ecs", "region-2"))
+	th.AssertEquals(t, "https://cfwforhcs-api.region-2.example.com/", GetServiceEndpoint(cfg, "cfw", "region-2"))
+
+	// the templated endpoints follow the cloud of the region in the profile
+	th.AssertEquals(t, "https://swr-api.region-1.hcs.example.com/", cfg.Endpoints["swr"])
+	th.AssertEquals(t, "https://swr-api.region-1.hcs.example.com/", GetServiceEndpoint(cfg, "swr", "region-1"))
+	th.AssertEquals(t, "https://swr-api.region-2.example.com/", GetServiceEndpoint(cfg, "swr", "region-2"))
+}
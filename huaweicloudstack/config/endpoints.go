@@ -277,6 +277,11 @@ var allServiceCatalog = map[string]ServiceCatalog{
 		WithOutProjectID: true,
 		Product:          "NAT",
 	},
+	"natv3": {
+		Name:    "nat",
+		Version: "v3",
+		Product: "NAT",
+	},
 	"elbv2": {
 		Name:             "vpc",
 		Version:          "v2.0",
@@ -825,25 +830,83 @@ var allServiceCatalog = map[string]ServiceCatalog{
 	},
 }
 
+// templatedEndpoints are the default endpoints of the services whose hosts are not the catalog names,
+// the placeholders are the region and the cloud.
+var templatedEndpoints = map[string]string{
+	"csms":          "https://csms-scc-apig.%s.%s/",
+	"cfw":           "https://cfwforhcs-api.%s.%s/",
+	"hss":           "https://hss-api.%s.%s/",
+	"kms":           "https://kms-scc-apig.%s.%s/",
+	"obs":           "https://obsv3.%s.%s/",
+	"opengauss":     "https://gaussdb.%s.%s/gaussdb/",
+	"opengaussv31":  "https://gaussdb.%s.%s/gaussdb/",
+	"secmaster":     "https://secmaster-tenant.%s.%s/",
+	"swr":           "https://swr-api.%s.%s/",
+	"waf":           "https://waf-api.%s.%s/",
+	"waf-dedicated": "https://waf-api.%s.%s/",
+}
+
+// templatedEndpoint returns the default endpoint of the service in the region, the cloud of the region in
+// the endpoint profile is used if specified.
+func (c *HcsConfig) templatedEndpoint(srv, region string) (string, bool) {
+	tmpl, ok := templatedEndpoints[srv]
+	if !ok {
+		return "", false
+	}
+	// the dedicated WAF uses the catalog endpoint if only the WAF endpoint is customized
+	if _, custom := c.UserEndpoints["waf"]; custom && srv == "waf-dedicated" {
+		return "", false
+	}
+
+	cloud := c.getCloud(region)
+	if cloud == "" {
+		return "", false
+	}
+	return fmt.Sprintf(tmpl, region, cloud), true
+}
+
+// setTemplatedEndpoints fills the default endpoints of the provider region which are not specified in the provider
+// block or the endpoint profile, they are also used by the clients of the upstream provider.
+func (c *HcsConfig) setTemplatedEndpoints() {
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	for srv := range templatedEndpoints {
+		if _, ok := c.Endpoints[srv]; ok {
+			continue
+		}
+		if endpoint, ok := c.templatedEndpoint(srv, c.Region); ok {
+			c.Endpoints[srv] = endpoint
+		}
+	}
+}
+
 // GetServiceEndpoint try to get the endpoint from customizing map
 func GetServiceEndpoint(c *HcsConfig, srv, region string) string {
-	if endpoint, ok := c.Endpoints[srv]; ok {
+	if endpoint, ok := c.getCustomEndpoint(srv, region); ok {
 		return endpoint
 	}
 
 	// get the endpoint from build-in catalog
-	catalog, ok := allServiceCatalog[srv]
+	catalog, ok := c.getServiceCatalog(srv, region)
 	if !ok {
 		return ""
 	}
+	return c.buildServiceEndpoint(srv, catalog, region)
+}
+
+// buildServiceEndpoint returns the endpoint in the endpoint profile or the templated endpoint of the service.
+func (c *HcsConfig) buildServiceEndpoint(srv string, catalog ServiceCatalog, region string) string {
+	if c.EndpointProfile != nil {
+		if endpoint := c.EndpointProfile.Endpoint(srv, region); endpoint != "" {
+			return endpoint
+		}
+	}
 
-	var ep string
 	if catalog.Scope == "global" && !c.RegionClient {
-		ep = fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
-	} else {
-		ep = fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
+		return fmt.Sprintf("https://%s.%s/", catalog.Name, c.getCloud(region))
 	}
-	return ep
+	return fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.getCloud(region))
 }
 
 // GetServiceCatalog returns the catalog object of a service
@@ -10,8 +10,26 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
+// EnableForceNewSchema returns the schema of the enable_force_new argument which overrides the provider-level
+// enable_force_new for a single resource, it should be declared by all resources that use FlexibleForceNew.
+func EnableForceNewSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+		Description: utils.SchemaDesc(
+			"Whether to replace the resource when the non-updatable arguments are changed, "+
+				"overrides the enable_force_new of the provider.",
+			utils.SchemaDescInput{},
+		),
+	}
+}
+
 // FlexibleForceNew make the ForceNew of parameters configurable
 // this func accepts a list of non-updatable parameters
 // when non-updatable parameters are changed
@@ -31,11 +49,20 @@ func FlexibleForceNew(keys []string, resourceSchemas ...map[string]*schema.Schem
 		keysExpand := expandKeys(keys, d)
 
 		if forceNew {
+			var changed []string
 			for _, k := range keysExpand {
+				if d.Id() != "" && d.HasChange(k) {
+					changed = append(changed, k)
+				}
 				if err := d.ForceNew(k); err != nil {
 					log.Printf("[WARN] unable to require attribute replacement of %s: %s", k, err)
 				}
 			}
+			// the SDK does not support the warnings in CustomizeDiff, so the replacement is reported in the log
+			if len(changed) > 0 {
+				log.Printf("[WARN] the resource %s will be replaced because the non-updatable attributes are changed: %s",
+					d.Id(), strings.Join(changed, ", "))
+			}
 		} else {
 			for _, k := range keysExpand {
 				if d.Id() != "" && d.HasChange(k) {
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func testForceNewDiff(providerForceNew bool, raw map[string]interface{}) (*terraform.InstanceDiff, error) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enable_force_new": EnableForceNewSchema(),
+		},
+		CustomizeDiff: FlexibleForceNew([]string{"name"}),
+	}
+	state := &terraform.InstanceState{
+		ID:         "resource-id",
+		Attributes: map[string]string{"id": "resource-id", "name": "old"},
+	}
+	cfg := &HcsConfig{EnableForceNew: providerForceNew}
+	cfg.Metadata = cfg
+	return resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &cfg.Config)
+}
+
+func TestFlexibleForceNew(t *testing.T) {
+	// the changes of the non-updatable parameters are rejected by default
+	_, err := testForceNewDiff(false, map[string]interface{}{"name": "new"})
+	th.AssertEquals(t, true, err != nil)
+
+	diff, err := testForceNewDiff(true, map[string]interface{}{"name": "new"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, diff.RequiresNew())
+
+	// the resource-level enable_force_new overrides the provider-level value in both directions
+	diff, err = testForceNewDiff(false, map[string]interface{}{"name": "new", "enable_force_new": "true"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, diff.RequiresNew())
+
+	_, err = testForceNewDiff(true, map[string]interface{}{"name": "new", "enable_force_new": "false"})
+	th.AssertEquals(t, true, err != nil)
+}
@@ -43,86 +43,104 @@ This file is used to impl the configuration of huaweicloudstack-sdk-go-v3 packag
 genetate service clients.
 */
 func buildAuthCredentials(c *HcsConfig, region string) (*basic.Credentials, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+	if err := c.refreshExpiringCredentials(); err != nil {
+		return nil, err
+	}
+
+	accessKey, secretKey, securityToken := c.currentCredentials()
+	if accessKey == "" || secretKey == "" {
 		return nil, fmt.Errorf("access_key or secret_key is missing in the provider")
 	}
 
 	credentials := basic.Credentials{
-		AK:            c.AccessKey,
-		SK:            c.SecretKey,
-		SecurityToken: c.SecurityToken,
+		AK:            accessKey,
+		SK:            secretKey,
+		SecurityToken: securityToken,
 		IamEndpoint:   c.IdentityEndpoint,
 	}
 
-	c.RPLock.Lock()
-	defer c.RPLock.Unlock()
-	projectID, ok := c.RegionProjectIDMap[region]
-	if !ok {
-		// Not find in the map, then try to query and store.
-		client := c.HcsHwClient
-		err := c.loadUserProjects(client, region)
-		if err != nil {
-			return nil, err
-		}
-		projectID = c.RegionProjectIDMap[region]
+	projectID, err := c.getProjectID(c.HcsHwClient, region)
+	if err != nil {
+		return nil, err
 	}
 
 	credentials.ProjectId = projectID
 	return &credentials, nil
 }
 
-func buildGlobalAuthCredentials(c *HcsConfig, region string) (*global.Credentials, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+func buildGlobalAuthCredentials(c *HcsConfig, domainID string) (*global.Credentials, error) {
+	if err := c.refreshExpiringCredentials(); err != nil {
+		return nil, err
+	}
+
+	accessKey, secretKey, securityToken := c.currentCredentials()
+	if accessKey == "" || secretKey == "" {
 		return nil, fmt.Errorf("access_key or secret_key is missing in the provider")
 	}
 
 	credentials := global.Credentials{
-		AK:            c.AccessKey,
-		SK:            c.SecretKey,
-		DomainId:      c.DomainID,
-		SecurityToken: c.SecurityToken,
+		AK:            accessKey,
+		SK:            secretKey,
+		DomainId:      domainID,
+		SecurityToken: securityToken,
 		IamEndpoint:   c.IdentityEndpoint,
 	}
 
 	return &credentials, nil
 }
 
-func buildHTTPConfig(c *HcsConfig) *hcconfig.HttpConfig {
+func buildHTTPConfig(c *HcsConfig, product string) (*hcconfig.HttpConfig, error) {
 	httpConfig := hcconfig.DefaultHttpConfig()
 
-	if c.MaxRetries > 0 {
-		httpConfig = httpConfig.WithRetries(c.MaxRetries)
+	// the transport is built here rather than by the SDK, so that the CA certificates, the client certificate and
+	// the retry policy are applied in the same way as the golangsdk clients
+	tlsConfig, err := generateTLSConfig(c)
+	if err != nil {
+		return nil, err
 	}
-
-	if c.Insecure {
-		httpConfig = httpConfig.WithIgnoreSSLVerification(true)
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
 	}
 
 	httpHandler := httphandler.NewHttpHandler().
-		AddRequestHandler(logRequestHandler).
+		AddRequestHandler(func(request http.Request) {
+			// the request handler is invoked before sending the request, so it's able to queue the request
+			if err := c.waitRateLimit(request.Context(), request.Method, request.URL); err != nil {
+				log.Printf("[WARN] failed to wait for the rate limit: %s", err)
+			}
+			logRequestHandler(request)
+		}).
 		AddResponseHandler(logResponseHandler)
 	httpConfig = httpConfig.WithHttpHandler(httpHandler)
 
 	if proxyURL := getProxyFromEnv(); proxyURL != "" {
 		if parsed, err := url.Parse(proxyURL); err == nil {
 			logp.Printf("[DEBUG] using https proxy: %s://%s", parsed.Scheme, parsed.Host)
-
-			httpProxy := hcconfig.Proxy{
-				Schema:   parsed.Scheme,
-				Host:     parsed.Host,
-				Username: parsed.User.Username(),
-			}
-			if pwd, ok := parsed.User.Password(); ok {
-				httpProxy.Password = pwd
-			}
-
-			httpConfig = httpConfig.WithProxy(&httpProxy)
+			transport.Proxy = http.ProxyURL(parsed)
 		} else {
 			logp.Printf("[WARN] parsing https proxy failed: %s", err)
 		}
 	}
 
-	return httpConfig
+	var rt http.RoundTripper = transport
+	if retryPolicy := c.GetRetryPolicy(product); retryPolicy.MaxAttempts > 0 {
+		rt = newRetryRoundTripper(rt, retryPolicy)
+	}
+	// the audit log records the requests in the same way as the golangsdk clients, the duration includes the retries
+	rt = &AuditRoundTripper{Rt: rt, Config: c}
+
+	return httpConfig.WithHttpTransport(wrapRoundTripper(rt)), nil
+}
+
+// wrapRoundTripper returns a transport which sends all requests through the round tripper.
+// The SDK builds the http.Client by itself and only accepts an *http.Transport in the HttpConfig, so a round tripper
+// can not be set directly, it is registered as the handler of the http and https schemes instead, and the returned
+// transport never dials any connection.
+func wrapRoundTripper(rt http.RoundTripper) *http.Transport {
+	transport := &http.Transport{}
+	transport.RegisterProtocol("https", rt)
+	transport.RegisterProtocol("http", rt)
+	return transport
 }
 
 // HcVpcV3Client is the VPC service client using huaweicloudstack-sdk-go-v3 package
@@ -162,6 +180,16 @@ func (c *HcsConfig) HcIamV3Client(region string) (*iamv3.IamClient, error) {
 	return iamv3.NewIamClient(hcClient), nil
 }
 
+// HcIamV3ClientWithDomain is the IAM service client using huaweicloudstack-sdk-go-v3 package,
+// the global credentials are bound to the specified domain rather than the provider-level one.
+func (c *HcsConfig) HcIamV3ClientWithDomain(region, domainID string) (*iamv3.IamClient, error) {
+	hcClient, err := newHcClient(c, region, "iam", true, domainID)
+	if err != nil {
+		return nil, err
+	}
+	return iamv3.NewIamClient(hcClient), nil
+}
+
 // HcCtsV3Client is the CTS service client using huaweicloudstack-sdk-go-v3 package
 func (c *HcsConfig) HcCtsV3Client(region string) (*ctsv3.CtsClient, error) {
 	hcClient, err := NewHcClient(c, region, "cts", false)
@@ -290,15 +318,23 @@ func (c *HcsConfig) HcHssV5Client(region string) (*hssv5.HssClient, error) {
 
 // NewHcClient is the common client using huaweicloudstack-sdk-go-v3 package
 func NewHcClient(c *HcsConfig, region, product string, globalFlag bool) (*core.HcHttpClient, error) {
+	return newHcClient(c, region, product, globalFlag, c.DomainID)
+}
+
+func newHcClient(c *HcsConfig, region, product string, globalFlag bool, domainID string) (*core.HcHttpClient, error) {
 	endpoint := GetServiceEndpoint(c, product, region)
 	if endpoint == "" {
 		return nil, fmt.Errorf("failed to get the endpoint of %q service in region %s", product, region)
 	}
 
-	builder := core.NewHcHttpClientBuilder().WithEndpoint(endpoint).WithHttpConfig(buildHTTPConfig(c))
+	httpConfig, err := buildHTTPConfig(c, product)
+	if err != nil {
+		return nil, err
+	}
+	builder := core.NewHcHttpClientBuilder().WithEndpoint(endpoint).WithHttpConfig(httpConfig)
 
 	if globalFlag {
-		credentials, err := buildGlobalAuthCredentials(c, region)
+		credentials, err := buildGlobalAuthCredentials(c, domainID)
 		if err != nil {
 			return nil, err
 		}
@@ -72,6 +72,7 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 	for response == nil {
 
 		if retry > lrt.MaxRetries {
+			recordRetries(request, retry-1)
 			log.Printf("[DEBUG] connection error, retries exhausted. Aborting")
 			err = fmt.Errorf("connection error, retries exhausted. Aborting. Last error was: %s", err)
 			return nil, err
@@ -84,6 +85,9 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 		response, err = lrt.Rt.RoundTrip(request)
 		retry++
 	}
+	if retry > 1 {
+		recordRetries(request, retry-1)
+	}
 
 	log.Printf("[DEBUG] API Response Code: %d", response.StatusCode)
 	log.Printf("[DEBUG] API Response Headers:\n%s", FormatHeaders(response.Header, "\n"))
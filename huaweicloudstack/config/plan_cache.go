@@ -0,0 +1,44 @@
+package config
+
+import "sync"
+
+// PlanCache caches the results of the queries sent by the plan-time validations, such as the flavors and the
+// availability zones of a region, so that they are queried once per provider instance instead of once per resource.
+type PlanCache struct {
+	lock  sync.Mutex
+	items map[string]*planCacheItem
+}
+
+type planCacheItem struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+func NewPlanCache() *PlanCache {
+	return &PlanCache{
+		items: make(map[string]*planCacheItem),
+	}
+}
+
+// Get returns the result of the query which is cached by the key, the query is called once even if the key is
+// requested concurrently. The failed queries are cached too, so the plan does not retry them for each resource.
+// A nil cache calls the query every time.
+func (c *PlanCache) Get(key string, query func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return query()
+	}
+
+	c.lock.Lock()
+	item, ok := c.items[key]
+	if !ok {
+		item = &planCacheItem{}
+		c.items[key] = item
+	}
+	c.lock.Unlock()
+
+	item.once.Do(func() {
+		item.value, item.err = query()
+	})
+	return item.value, item.err
+}
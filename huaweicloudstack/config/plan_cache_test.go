@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestPlanCache(t *testing.T) {
+	cache := NewPlanCache()
+	var lock sync.Mutex
+	calls := map[string]int{}
+	query := func(key string) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			calls[key]++
+			if key == "bad" {
+				return nil, fmt.Errorf("query failed")
+			}
+			return "value-" + key, nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cache.Get("flavors", query("flavors"))
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, "value-flavors", value)
+		}()
+	}
+	wg.Wait()
+	th.AssertEquals(t, 1, calls["flavors"])
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.Get("bad", query("bad"))
+		th.AssertEquals(t, true, err != nil)
+	}
+	th.AssertEquals(t, 1, calls["bad"])
+
+	// the nil cache does not cache the results
+	var nilCache *PlanCache
+	for i := 0; i < 2; i++ {
+		_, err := nilCache.Get("zones", query("zones"))
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, 2, calls["zones"])
+}
@@ -0,0 +1,311 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/projects"
+)
+
+// projectScope is the project specified in the resource, it overrides the project of the provider.
+// Only one of the ID and name is set, the ID of the project name is queried at the first use.
+type projectScope struct {
+	id   string
+	name string
+}
+
+// ScopeResourceProject adds the optional project_id and project_name arguments to the project-scoped resource
+// (or data source), and wraps its CRUD functions to manage it in the specified project. A resource (or data source)
+// is project-scoped if it has the region argument, and the resources that already have either argument are skipped.
+// The resource is only replaced if the project in which it is managed is changed, see projectScopeDiff.
+// It must be called before TraceResource, so the traced operations are done with the scoped config.
+func ScopeResourceProject(isDataSource bool, r *schema.Resource) {
+	if r.Schema == nil || r.Schema["region"] == nil || r.Schema["project_id"] != nil || r.Schema["project_name"] != nil {
+		return
+	}
+
+	r.Schema["project_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      !isDataSource,
+		ForceNew:      !isDataSource,
+		ConflictsWith: []string{"project_name"},
+		Description:   "The ID of the project in which to manage the resource, it overrides the provider-level project.",
+	}
+	r.Schema["project_name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      !isDataSource,
+		ForceNew:      !isDataSource,
+		ConflictsWith: []string{"project_id"},
+		Description:   "The name of the project in which to manage the resource, it overrides the provider-level project.",
+	}
+
+	if !isDataSource {
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customdiff.All(projectScopeDiff, r.CustomizeDiff)
+		} else {
+			r.CustomizeDiff = projectScopeDiff
+		}
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = scopeContextFunc(r.CreateContext)
+	}
+	if r.CreateWithoutTimeout != nil {
+		r.CreateWithoutTimeout = scopeContextFunc(r.CreateWithoutTimeout)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = scopeContextFunc(r.ReadContext)
+	}
+	if r.ReadWithoutTimeout != nil {
+		r.ReadWithoutTimeout = scopeContextFunc(r.ReadWithoutTimeout)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = scopeContextFunc(r.UpdateContext)
+	}
+	if r.UpdateWithoutTimeout != nil {
+		r.UpdateWithoutTimeout = scopeContextFunc(r.UpdateWithoutTimeout)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = scopeContextFunc(r.DeleteContext)
+	}
+	if r.DeleteWithoutTimeout != nil {
+		r.DeleteWithoutTimeout = scopeContextFunc(r.DeleteWithoutTimeout)
+	}
+
+	//nolint:staticcheck // the deprecated functions are still used by some resources
+	if r.Create != nil {
+		r.Create = scopeFunc(r.Create)
+	}
+	//nolint:staticcheck
+	if r.Read != nil {
+		r.Read = scopeFunc(r.Read)
+	}
+	//nolint:staticcheck
+	if r.Update != nil {
+		r.Update = scopeFunc(r.Update)
+	}
+	//nolint:staticcheck
+	if r.Delete != nil {
+		r.Delete = scopeFunc(r.Delete)
+	}
+}
+
+// projectScopeDiff compares the project in which the resource is managed with the project of the configuration,
+// the changes of the project arguments are cleared if both are the same project, e.g. the project_name of the
+// provider-level project is added, otherwise the resource is replaced. The arguments are computed, so the removed
+// arguments are also compared, and the omitted arguments of a new resource are planned as empty.
+func projectScopeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := GetHcsConfig(meta)
+	rawConfig := d.GetRawConfig()
+	if cfg == nil || rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawID, rawName := rawConfig.GetAttr("project_id"), rawConfig.GetAttr("project_name")
+	if !rawID.IsKnown() || !rawName.IsKnown() {
+		return nil
+	}
+	newID, newName := rawConfigString(rawID), rawConfigString(rawName)
+
+	var mErr *multierror.Error
+	if d.Id() == "" {
+		mErr = multierror.Append(mErr, d.SetNew("project_id", newID), d.SetNew("project_name", newName))
+		return mErr.ErrorOrNil()
+	}
+
+	oldID, _ := d.GetChange("project_id")
+	oldName, _ := d.GetChange("project_name")
+	if oldID.(string) == newID && oldName.(string) == newName {
+		return nil
+	}
+
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	oldProjectID, err := cfg.resourceProjectID(region, oldID.(string), oldName.(string))
+	var newProjectID string
+	if err == nil {
+		newProjectID, err = cfg.resourceProjectID(region, newID, newName)
+	}
+	if err != nil {
+		log.Printf("[WARN] unable to compare the projects of the resource, it will be replaced: %s", err)
+	} else if oldProjectID == newProjectID {
+		log.Printf("[DEBUG] the resource is managed in the project %s, the changes of the project are ignored",
+			oldProjectID)
+		mErr = multierror.Append(mErr, d.Clear("project_id"), d.Clear("project_name"))
+		return mErr.ErrorOrNil()
+	}
+	// set the removed arguments, so the resource is replaced by the ForceNew
+	mErr = multierror.Append(mErr, d.SetNew("project_id", newID), d.SetNew("project_name", newName))
+	return mErr.ErrorOrNil()
+}
+
+// rawConfigString returns the string in the raw configuration, it is empty if the argument is not specified.
+func rawConfigString(v cty.Value) string {
+	if v.IsNull() {
+		return ""
+	}
+	return v.AsString()
+}
+
+func scopeContextFunc(f contextOperationFunc) contextOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, withResourceProject(d, meta))
+	}
+}
+
+func scopeFunc(f operationFunc) operationFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, withResourceProject(d, meta))
+	}
+}
+
+// withResourceProject returns the meta of the operation, it is scoped to the project specified in the resource.
+func withResourceProject(d *schema.ResourceData, meta interface{}) interface{} {
+	projectID, projectName := d.Get("project_id").(string), d.Get("project_name").(string)
+	cfg := GetHcsConfig(meta)
+	if cfg == nil || (projectID == "" && projectName == "") {
+		return meta
+	}
+	return &cfg.withProjectScope(projectID, projectName).Config
+}
+
+// withProjectScope returns a copy of the config for a single Terraform operation, the service clients created by
+// the copy are scoped to the specified project. The copy has its own maps and RPLock, so the project IDs queried by
+// it never change the config of the provider directly, they are merged into the provider config by getProjectID.
+func (c *HcsConfig) withProjectScope(projectID, projectName string) *HcsConfig {
+	root := c
+	if c.parent != nil {
+		root = c.parent
+	} else if c.SecurityKeyLock != nil {
+		c.SecurityKeyLock.Lock()
+		defer c.SecurityKeyLock.Unlock()
+	}
+
+	clone := *c
+	clone.parent = root
+	clone.projectScope = &projectScope{
+		id:   projectID,
+		name: projectName,
+	}
+	clone.Metadata = &clone
+
+	if c.RPLock != nil {
+		c.RPLock.Lock()
+		defer c.RPLock.Unlock()
+	}
+	clone.RPLock = new(sync.Mutex)
+	clone.RegionProjectIDMap = copyStringMap(c.RegionProjectIDMap)
+	clone.ScopedProjectIDMap = copyStringMap(c.ScopedProjectIDMap)
+	clone.Endpoints = copyStringMap(c.Endpoints)
+	clone.UserEndpoints = copyStringMap(c.UserEndpoints)
+	clone.DefaultTags = copyStringMap(c.DefaultTags)
+
+	clone.ServiceRetryPolicies = make(map[string]RetryPolicy, len(c.ServiceRetryPolicies))
+	for srv, policy := range c.ServiceRetryPolicies {
+		clone.ServiceRetryPolicies[srv] = policy
+	}
+	// the rate limiters are shared, so the QPS of the scoped operations are still limited by the provider
+	clone.ServiceRateLimiters = make(map[string]*RateLimiter, len(c.ServiceRateLimiters))
+	for srv, limiter := range c.ServiceRateLimiters {
+		clone.ServiceRateLimiters[srv] = limiter
+	}
+	return &clone
+}
+
+// resourceProjectID returns the ID of the project in which the resource is managed, it is the project of the region
+// if neither the project ID nor the project name is specified.
+func (c *HcsConfig) resourceProjectID(region, projectID, projectName string) (string, error) {
+	if projectID == "" && projectName == "" {
+		return c.getProjectID(c.HcsHwClient, region)
+	}
+	return c.withProjectScope(projectID, projectName).getProjectID(c.HcsHwClient, region)
+}
+
+// isRegionProject checks whether the project belongs to the region, the name of a project is the region name or is
+// prefixed by it, such as cn-north-1_test, and the parent of a sub-project is the project of the region.
+// The caller must hold the RPLock.
+func (c *HcsConfig) isRegionProject(project projects.Project, region string) bool {
+	if project.Name == region || strings.HasPrefix(project.Name, region+"_") {
+		return true
+	}
+	regionProjectID, ok := c.RegionProjectIDMap[region]
+	return ok && project.ParentID == regionProjectID
+}
+
+// getProjectID returns the ID of the project which the service clients of the region are scoped to, it is the
+// project specified in the resource, or the project of the region by default.
+// The project IDs are queried by loadUserProjects and cached per region and project at the first use.
+func (c *HcsConfig) getProjectID(client *golangsdk.ProviderClient, region string) (string, error) {
+	// The token of the provider is scoped to the provider-level project, so the requests can not be sent to
+	// another project, just like the Resource-level region.
+	if c.projectScope != nil && !c.isAKSKAuth() {
+		return "", fmt.Errorf("Resource-level project_id and project_name are only supported when using AK/SK authentication")
+	}
+	if c.projectScope != nil && c.projectScope.id != "" {
+		return c.projectScope.id, nil
+	}
+
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+
+	if c.projectScope != nil {
+		key := scopedProjectKey(region, c.projectScope.name)
+		if projectID, ok := c.ScopedProjectIDMap[key]; ok {
+			return projectID, nil
+		}
+		// Not find in the map, then try to query and store.
+		if err := c.loadUserProjects(client, region, c.projectScope.name); err != nil {
+			return "", err
+		}
+		// the scoped config has its own copy of the maps, so keep the queried project ID for the other operations
+		if c.parent != nil {
+			c.parent.mergeProjectIDs(c)
+		}
+		return c.ScopedProjectIDMap[key], nil
+	}
+
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		// Not find in the map, then try to query and store.
+		if err := c.loadUserProjects(client, region, ""); err != nil {
+			return "", err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+	return projectID, nil
+}
+
+// withScopedProject returns a copy of the ProviderClient with the project specified in the resource,
+// the client is returned directly if the config is not scoped.
+func (c *HcsConfig) withScopedProject(client *golangsdk.ProviderClient,
+	region string) (*golangsdk.ProviderClient, error) {
+	if c.projectScope == nil {
+		return client, nil
+	}
+
+	projectID, err := c.getProjectID(client, region)
+	if err != nil {
+		return nil, err
+	}
+	clone := cloneProviderClient(client)
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	return clone, nil
+}
+
+func scopedProjectKey(region, projectName string) string {
+	return fmt.Sprintf("%s/%s", region, projectName)
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/identity/v3/projects"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestScopeResourceProject(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.Metadata = cfg
+	cfg.AccessKey, cfg.SecretKey = "ak", "sk"
+
+	var opConfig *HcsConfig
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		ReadContext: func(_ context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			opConfig = GetHcsConfig(meta)
+			return nil
+		},
+	}
+	ScopeResourceProject(false, resource)
+	th.AssertEquals(t, true, resource.Schema["project_id"].ForceNew)
+	th.AssertEquals(t, true, resource.Schema["project_name"].ForceNew)
+	th.AssertEquals(t, true, resource.CustomizeDiff != nil)
+
+	// the config of the provider is used if the project is not specified
+	d := resource.TestResourceData()
+	th.AssertEquals(t, false, resource.ReadContext(context.Background(), d, &cfg.Config).HasError())
+	th.AssertEquals(t, cfg, opConfig)
+
+	th.AssertNoErr(t, d.Set("project_id", "project-1"))
+	th.AssertEquals(t, false, resource.ReadContext(context.Background(), d, &cfg.Config).HasError())
+	th.AssertEquals(t, cfg, opConfig.parent)
+	projectID, err := opConfig.getProjectID(nil, "region-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "project-1", projectID)
+
+	// the global resources and the resources which have the project arguments are not changed
+	global := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	ScopeResourceProject(false, global)
+	th.AssertEquals(t, true, global.Schema["project_id"] == nil)
+}
+
+func TestProjectScopeDiff(t *testing.T) {
+	cfg := &HcsConfig{
+		ScopedProjectIDMap: map[string]string{"region-1/region-1_test": "project-2"},
+	}
+	cfg.Metadata = cfg
+	cfg.Region = "region-1"
+	cfg.AccessKey, cfg.SecretKey = "ak", "sk"
+	cfg.RPLock = new(sync.Mutex)
+	cfg.RegionProjectIDMap = map[string]string{"region-1": "project-1"}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+		UpdateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+	ScopeResourceProject(false, resource)
+
+	cases := []struct {
+		name              string
+		stateProjectID    string
+		configProjectID   string
+		configProjectName string
+		wantChanged       bool
+	}{
+		{
+			name:            "project ID of the provider-level project",
+			configProjectID: "project-1",
+		},
+		{
+			name:              "project name of another project",
+			configProjectName: "region-1_test",
+			wantChanged:       true,
+		},
+		{
+			name:              "project name of the same project",
+			stateProjectID:    "project-2",
+			configProjectName: "region-1_test",
+		},
+		{
+			name:            "project ID not changed",
+			stateProjectID:  "project-2",
+			configProjectID: "project-2",
+		},
+		{
+			name:           "project removed",
+			stateProjectID: "project-2",
+			wantChanged:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rawConfig := map[string]interface{}{"name": "test"}
+			configValues := map[string]cty.Value{
+				"name":         cty.StringVal("test"),
+				"region":       cty.NullVal(cty.String),
+				"project_id":   cty.NullVal(cty.String),
+				"project_name": cty.NullVal(cty.String),
+			}
+			if tc.configProjectID != "" {
+				rawConfig["project_id"] = tc.configProjectID
+				configValues["project_id"] = cty.StringVal(tc.configProjectID)
+			}
+			if tc.configProjectName != "" {
+				rawConfig["project_name"] = tc.configProjectName
+				configValues["project_name"] = cty.StringVal(tc.configProjectName)
+			}
+			state := &terraform.InstanceState{
+				ID: "resource-id",
+				Attributes: map[string]string{
+					"id":           "resource-id",
+					"region":       "region-1",
+					"name":         "test",
+					"project_id":   tc.stateProjectID,
+					"project_name": "",
+				},
+				RawConfig: cty.ObjectVal(configValues),
+			}
+
+			diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig), cfg)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, tc.wantChanged, diff != nil && len(diff.Attributes) > 0)
+			th.AssertEquals(t, tc.wantChanged, diff.RequiresNew())
+		})
+	}
+}
+
+func TestGetScopedProjectID(t *testing.T) {
+	cfg := &HcsConfig{
+		ScopedProjectIDMap: map[string]string{"region-1/region-1_test": "project-2"},
+	}
+	cfg.Metadata = cfg
+	cfg.RPLock = new(sync.Mutex)
+	cfg.RegionProjectIDMap = map[string]string{"region-1": "project-1"}
+
+	projectID, err := cfg.getProjectID(nil, "region-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "project-1", projectID)
+
+	// the token is scoped to the provider-level project, so the AK/SK is required
+	scoped := cfg.withProjectScope("", "region-1_test")
+	_, err = scoped.getProjectID(nil, "region-1")
+	th.AssertEquals(t, true, err != nil)
+
+	cfg.AccessKey, cfg.SecretKey = "ak", "sk"
+	scoped = cfg.withProjectScope("", "region-1_test")
+	projectID, err = scoped.getProjectID(nil, "region-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "project-2", projectID)
+	th.AssertEquals(t, "project-1", cfg.GetProjectID("region-1"))
+}
+
+func TestWithProjectScope_copyMaps(t *testing.T) {
+	cfg := &HcsConfig{
+		ScopedProjectIDMap: map[string]string{"region-1/region-1_test": "project-2"},
+		UserEndpoints:      map[string]string{"ecs": "https://ecs.example.com/"},
+	}
+	cfg.Metadata = cfg
+	cfg.RPLock = new(sync.Mutex)
+	cfg.RegionProjectIDMap = map[string]string{"region-1": "project-1"}
+
+	scoped := cfg.withProjectScope("", "region-1_test")
+	th.AssertEquals(t, true, scoped.RPLock != cfg.RPLock)
+	scoped.RegionProjectIDMap["region-2"] = "project-3"
+	scoped.ScopedProjectIDMap["region-2/region-2_test"] = "project-4"
+	scoped.UserEndpoints["vpc"] = "https://vpc.example.com/"
+	th.AssertEquals(t, 1, len(cfg.RegionProjectIDMap))
+	th.AssertEquals(t, 1, len(cfg.ScopedProjectIDMap))
+	th.AssertEquals(t, 1, len(cfg.UserEndpoints))
+
+	// the project IDs queried by the scoped config are merged into the config of the provider
+	cfg.mergeProjectIDs(scoped)
+	th.AssertEquals(t, "project-3", cfg.RegionProjectIDMap["region-2"])
+	th.AssertEquals(t, "project-4", cfg.ScopedProjectIDMap["region-2/region-2_test"])
+}
+
+func TestIsRegionProject(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.RegionProjectIDMap = map[string]string{"region-1": "project-1"}
+
+	th.AssertEquals(t, true, cfg.isRegionProject(projects.Project{Name: "region-1"}, "region-1"))
+	th.AssertEquals(t, true, cfg.isRegionProject(projects.Project{Name: "region-1_test"}, "region-1"))
+	th.AssertEquals(t, true, cfg.isRegionProject(projects.Project{Name: "test", ParentID: "project-1"}, "region-1"))
+	th.AssertEquals(t, false, cfg.isRegionProject(projects.Project{Name: "region-10_test"}, "region-1"))
+	th.AssertEquals(t, false, cfg.isRegionProject(projects.Project{Name: "region-2_test", ParentID: "project-2"},
+		"region-1"))
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which limits the QPS of the API requests on the client side.
+// The requests exceeding the limit will be queued instead of being rejected by the API gateway.
+type RateLimiter struct {
+	name  string
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter which allows rate requests per second with the bursts of at most burst requests.
+// If burst is not a positive value, it will be set to the integer part of the rate (at least 1).
+func NewRateLimiter(name string, rate float64, burst int) *RateLimiter {
+	if burst <= 0 {
+		burst = int(rate)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+
+	return &RateLimiter{
+		name:   name,
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns the wait time until the token is available.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request is allowed to be sent or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context, target string) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] the rate limit (%v requests per second) of %s is reached, the request %s is throttled for %s",
+		l.rate, l.name, target, delay)
+	if ctx == nil {
+		//lintignore:R018
+		time.Sleep(delay)
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimitRoundTripper satisfies the http.RoundTripper interface and is used to
+// wait for the rate limiters of the provider before sending the requests.
+type RateLimitRoundTripper struct {
+	Rt     http.RoundTripper
+	Config *HcsConfig
+}
+
+// RoundTrip waits for the rate limiters and performs a round-trip HTTP request.
+func (rrt *RateLimitRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := rrt.Config.waitRateLimit(request.Context(), request.Method, request.URL); err != nil {
+		return nil, err
+	}
+	return rrt.Rt.RoundTrip(request)
+}
+
+// waitRateLimit waits for the provider-level rate limiter and the rate limiter of the service
+// which the request is sent to, the service is matched by the host of the request URL.
+func (c *HcsConfig) waitRateLimit(ctx context.Context, method string, u *url.URL) error {
+	if u == nil || (c.RateLimiter == nil && len(c.ServiceRateLimiters) == 0) {
+		return nil
+	}
+
+	target := fmt.Sprintf("%s %s%s", method, u.Host, u.Path)
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx, target); err != nil {
+			return err
+		}
+	}
+
+	for srv, limiter := range c.ServiceRateLimiters {
+		if c.isServiceHost(srv, u.Host) {
+			if err := limiter.Wait(ctx, target); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isServiceHost checks whether the host belongs to the endpoint of the service catalog.
+func (c *HcsConfig) isServiceHost(srv, host string) bool {
+	if endpoint, ok := c.Endpoints[srv]; ok {
+		if parsed, err := url.Parse(endpoint); err == nil {
+			return parsed.Host == host
+		}
+		return false
+	}
+
+	catalog, ok := allServiceCatalog[srv]
+	if !ok || catalog.Name == "" {
+		return false
+	}
+	return strings.HasPrefix(host, catalog.Name+".")
+}
+
+func validateRateLimiters(c *HcsConfig) error {
+	for srv := range c.ServiceRateLimiters {
+		if _, ok := allServiceCatalog[srv]; !ok {
+			return fmt.Errorf("the service %s in rate_limit block is invalid or not supported", srv)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter("test", 20, 2)
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		th.AssertNoErr(t, limiter.Wait(context.Background(), "GET /"))
+	}
+	// 2 requests are allowed by the burst, and the other 4 requests are queued for 50 milliseconds each
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("the requests are not throttled, elapsed: %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	th.AssertEquals(t, context.Canceled, limiter.Wait(ctx, "GET /"))
+}
+
+func TestIsServiceHost(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.Endpoints = map[string]string{
+		"rds": "https://rds-custom.region-0.mycloud.com/",
+	}
+
+	th.AssertEquals(t, true, cfg.isServiceHost("ecs", "ecs.region-0.mycloud.com"))
+	th.AssertEquals(t, false, cfg.isServiceHost("ecs", "evs.region-0.mycloud.com"))
+	th.AssertEquals(t, true, cfg.isServiceHost("rds", "rds-custom.region-0.mycloud.com"))
+	th.AssertEquals(t, false, cfg.isServiceHost("rds", "rds.region-0.mycloud.com"))
+
+	u, _ := url.Parse("https://ecs.region-0.mycloud.com/v1/servers")
+	th.AssertNoErr(t, cfg.waitRateLimit(context.Background(), "GET", u))
+}
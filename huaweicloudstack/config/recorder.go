@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/recorder"
+)
+
+type recorderContextKey struct{}
+
+// ContextWithRecorder returns a copy of the context which carries the HTTP recorder, the context is passed to the
+// ConfigureContextFunc of the provider by the acceptance tests to record or replay the API requests.
+func ContextWithRecorder(ctx context.Context, r *recorder.Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, r)
+}
+
+// RecorderFromContext returns the HTTP recorder carried by the context, or nil if there is not.
+func RecorderFromContext(ctx context.Context) *recorder.Recorder {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(recorderContextKey{}).(*recorder.Recorder)
+	return r
+}
+
+// MaskRecordedFields masks the sensitive fields of the JSON bodies written to the cassettes, the same fields as
+// the logs are masked. Unlike maskSecurityFields, the large strings are kept and the lists are masked recursively.
+func MaskRecordedFields(data map[string]interface{}) {
+	for k, val := range data {
+		if isSecurityFields(k) {
+			switch val.(type) {
+			case string:
+				data[k] = "***"
+			case map[string]interface{}:
+				data[k] = map[string]interface{}{"***": "***"}
+			}
+			continue
+		}
+		maskRecordedValue(val)
+	}
+}
+
+func maskRecordedValue(val interface{}) {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		MaskRecordedFields(val)
+	case []interface{}:
+		for _, v := range val {
+			maskRecordedValue(v)
+		}
+	}
+}
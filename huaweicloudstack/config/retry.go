@@ -0,0 +1,280 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+)
+
+// RetryPolicy describes how the API requests are retried when a retryable error is responded.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of retries, zero means the `max_retries` of the provider is used.
+	MaxAttempts int
+	// InitialBackoff is the wait time before the first retry, it doubles after each retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper limit of the wait time.
+	MaxBackoff time.Duration
+	// Jitter randomizes the wait time to avoid the retries of the parallel requests are sent at the same time.
+	Jitter bool
+	// RetryableStatusCodes is the list of HTTP status codes which can be retried.
+	RetryableStatusCodes []int
+	// RetryableErrorCodes is the list of API error codes (such as `APIGW.0308`) which can be retried.
+	RetryableErrorCodes []string
+	// RetryNonIdempotent allows the POST and PATCH requests to be retried with the RetryableStatusCodes,
+	// by default they are only retried with the StatusTooManyRequests response code and the RetryableErrorCodes.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryPolicy keeps the backoff behavior of the StatusTooManyRequests response code: sleep 1, 2, 4... minutes
+// and won't wait more than 30 minutes.
+var defaultRetryPolicy = RetryPolicy{
+	InitialBackoff:       time.Minute,
+	MaxBackoff:           30 * time.Minute,
+	RetryableStatusCodes: []int{http.StatusTooManyRequests},
+}
+
+// GetRetryPolicy returns the retry policy of the specified service catalog.
+// The fields which are not set in the service-level policy are inherited from the provider-level policy.
+func (c *HcsConfig) GetRetryPolicy(srv string) RetryPolicy {
+	policy := defaultRetryPolicy
+	policy.MaxAttempts = c.MaxRetries
+	if c.RetryPolicy != nil {
+		policy = mergeRetryPolicy(policy, *c.RetryPolicy)
+	}
+
+	if srvPolicy, ok := c.ServiceRetryPolicies[srv]; ok {
+		policy = mergeRetryPolicy(policy, srvPolicy)
+	}
+	return policy
+}
+
+func mergeRetryPolicy(base, override RetryPolicy) RetryPolicy {
+	if override.MaxAttempts > 0 {
+		base.MaxAttempts = override.MaxAttempts
+	}
+	if override.InitialBackoff > 0 {
+		base.InitialBackoff = override.InitialBackoff
+	}
+	if override.MaxBackoff > 0 {
+		base.MaxBackoff = override.MaxBackoff
+	}
+	if override.Jitter {
+		base.Jitter = true
+	}
+	if override.RetryNonIdempotent {
+		base.RetryNonIdempotent = true
+	}
+	if len(override.RetryableStatusCodes) > 0 {
+		base.RetryableStatusCodes = override.RetryableStatusCodes
+	}
+	if len(override.RetryableErrorCodes) > 0 {
+		base.RetryableErrorCodes = override.RetryableErrorCodes
+	}
+	return base
+}
+
+func validateRetryPolicies(c *HcsConfig) error {
+	for srv, policy := range c.ServiceRetryPolicies {
+		if _, ok := allServiceCatalog[srv]; !ok {
+			return fmt.Errorf("the service %s in retry block is invalid or not supported", srv)
+		}
+		if policy.MaxBackoff > 0 && policy.InitialBackoff > policy.MaxBackoff {
+			return fmt.Errorf("the initial_backoff of service %s can not be greater than max_backoff", srv)
+		}
+	}
+
+	if p := c.RetryPolicy; p != nil && p.MaxBackoff > 0 && p.InitialBackoff > p.MaxBackoff {
+		return fmt.Errorf("the initial_backoff in retry block can not be greater than max_backoff")
+	}
+	return nil
+}
+
+// Backoff returns the wait time before the retry with the specified number (begin with 0).
+func (p RetryPolicy) Backoff(retries uint) time.Duration {
+	backoff := time.Duration(float64(p.InitialBackoff) * math.Pow(2, float64(retries)))
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+
+	// equal jitter: keep half of the backoff and randomize the other half
+	if p.Jitter && backoff > 1 {
+		half := backoff / 2
+		//nolint:gosec
+		backoff = half + time.Duration(rand.Int63n(int64(half)))
+	}
+	return backoff
+}
+
+// IsRetryable checks whether the unexpected response can be retried according to the status code and error code.
+func (p RetryPolicy) IsRetryable(respErr *golangsdk.ErrUnexpectedResponseCode) bool {
+	if respErr == nil {
+		return false
+	}
+
+	// the server may have processed the non-idempotent request before the unexpected response is returned,
+	// so it is not replayed unless the policy opts in or the request is throttled.
+	statusRetryable := p.RetryNonIdempotent || respErr.Actual == http.StatusTooManyRequests ||
+		isIdempotentMethod(respErr.Method)
+	for _, code := range p.RetryableStatusCodes {
+		if statusRetryable && respErr.Actual == code {
+			return true
+		}
+	}
+
+	if len(p.RetryableErrorCodes) == 0 {
+		return false
+	}
+	errorCode := parseErrorCode(respErr.Body)
+	for _, code := range p.RetryableErrorCodes {
+		if errorCode != "" && errorCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return false
+	}
+	return true
+}
+
+// BackoffFunc returns the golangsdk.RetryFunc which sleeps the backoff time of this policy.
+func (p RetryPolicy) BackoffFunc() golangsdk.RetryFunc {
+	return func(ctx context.Context, respErr *golangsdk.ErrUnexpectedResponseCode, e error, retries uint) error {
+		sleep := p.Backoff(retries)
+		log.Printf("[WARN] Received retryable response code %d, try to sleep %s (retry %d of %d)",
+			respErr.Actual, sleep, retries+1, p.MaxAttempts)
+
+		if ctx != nil {
+			select {
+			case <-time.After(sleep):
+			case <-ctx.Done():
+				return e
+			}
+		} else {
+			//lintignore:R018
+			time.Sleep(sleep)
+		}
+
+		return nil
+	}
+}
+
+// parseErrorCode tries to get the error code from the response body, the formats likes:
+// {"error_code": "xxx"}, {"code": "xxx"} and {"error": {"code": "xxx"}}
+func parseErrorCode(body []byte) string {
+	var data map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return ""
+	}
+
+	if v, ok := data["error"].(map[string]interface{}); ok {
+		data = v
+	}
+	for _, key := range []string{"error_code", "errorCode", "code"} {
+		if v, ok := data[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// withRetryPolicy returns a copy of the ProviderClient which uses the specified retry policy.
+func withRetryPolicy(client *golangsdk.ProviderClient, policy RetryPolicy) *golangsdk.ProviderClient {
+	clone := cloneProviderClient(client)
+	setRetryPolicy(clone, policy)
+	return clone
+}
+
+func setRetryPolicy(client *golangsdk.ProviderClient, policy RetryPolicy) {
+	if policy.MaxAttempts <= 0 {
+		return
+	}
+
+	client.MaxBackoffRetries = uint(policy.MaxAttempts)
+	client.RetryBackoffFunc = policy.BackoffFunc()
+	client.RetryableFunc = policy.IsRetryable
+}
+
+// RetryRoundTripper retries the requests at the HTTP layer. It is used by the clients whose retry behavior can not be
+// configured with a RetryPolicy, such as the huaweicloud-sdk-go-v3 clients and the upstream ProviderClient.
+type RetryRoundTripper struct {
+	Rt            http.RoundTripper
+	MaxAttempts   uint
+	RetryableFunc func(*golangsdk.ErrUnexpectedResponseCode) bool
+	BackoffFunc   golangsdk.RetryFunc
+}
+
+// RoundTrip sends the request and retries it when the response is retryable.
+func (rt *RetryRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if rt.MaxAttempts == 0 || rt.RetryableFunc == nil || rt.BackoffFunc == nil {
+		return rt.Rt.RoundTrip(request)
+	}
+
+	// the request body is buffered so that it can be sent again
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for retries := uint(0); ; retries++ {
+		req := request.Clone(request.Context())
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := rt.Rt.RoundTrip(req)
+		if err != nil || response.StatusCode < http.StatusBadRequest || retries >= rt.MaxAttempts {
+			return response, err
+		}
+
+		respBody, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		respErr := &golangsdk.ErrUnexpectedResponseCode{
+			URL:    request.URL.String(),
+			Method: request.Method,
+			Actual: response.StatusCode,
+			Body:   respBody,
+		}
+		if !rt.RetryableFunc(respErr) {
+			return response, nil
+		}
+
+		// the backoff function returns an error when the context is done, then the last response is returned
+		if rt.BackoffFunc(request.Context(), respErr, respErr, retries) != nil {
+			return response, nil
+		}
+	}
+}
+
+// newRetryRoundTripper returns a RetryRoundTripper which retries the requests with the specified retry policy.
+func newRetryRoundTripper(rt http.RoundTripper, policy RetryPolicy) *RetryRoundTripper {
+	return &RetryRoundTripper{
+		Rt:            rt,
+		MaxAttempts:   uint(policy.MaxAttempts),
+		RetryableFunc: policy.IsRetryable,
+		BackoffFunc:   policy.BackoffFunc(),
+	}
+}
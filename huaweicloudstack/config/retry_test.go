@@ -0,0 +1,198 @@
+package config
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestGetRetryPolicy(t *testing.T) {
+	cfg := &HcsConfig{}
+	cfg.MaxRetries = 5
+
+	// without retry block, keep the behavior of max_retries
+	policy := cfg.GetRetryPolicy("rds")
+	th.AssertEquals(t, 5, policy.MaxAttempts)
+	th.AssertEquals(t, time.Minute, policy.InitialBackoff)
+	th.AssertEquals(t, 30*time.Minute, policy.MaxBackoff)
+
+	cfg.RetryPolicy = &RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+	cfg.ServiceRetryPolicies = map[string]RetryPolicy{
+		"rds": {
+			MaxAttempts:          10,
+			RetryableStatusCodes: []int{429, 503},
+		},
+	}
+
+	policy = cfg.GetRetryPolicy("ecs")
+	th.AssertEquals(t, 5, policy.MaxAttempts)
+	th.AssertEquals(t, time.Second, policy.InitialBackoff)
+	th.AssertDeepEquals(t, []int{429}, policy.RetryableStatusCodes)
+
+	policy = cfg.GetRetryPolicy("rds")
+	th.AssertEquals(t, 10, policy.MaxAttempts)
+	th.AssertEquals(t, time.Minute, policy.MaxBackoff)
+	th.AssertDeepEquals(t, []int{429, 503}, policy.RetryableStatusCodes)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+
+	th.AssertEquals(t, time.Second, policy.Backoff(0))
+	th.AssertEquals(t, 8*time.Second, policy.Backoff(3))
+	th.AssertEquals(t, 10*time.Second, policy.Backoff(4))
+
+	// with jitter, the backoff is randomized between the half and the whole
+	policy.Jitter = true
+	for i := 0; i < 10; i++ {
+		backoff := policy.Backoff(4)
+		if backoff < 5*time.Second || backoff >= 10*time.Second {
+			t.Fatalf("the backoff %s with jitter is out of range", backoff)
+		}
+	}
+}
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := RetryPolicy{
+		RetryableStatusCodes: []int{429},
+		RetryableErrorCodes:  []string{"APIGW.0308", "RDS.0005"},
+	}
+
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Actual: 429}))
+	th.AssertEquals(t, false, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Actual: 500}))
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{
+		Actual: 500,
+		Body:   []byte(`{"error_code": "APIGW.0308", "error_msg": "The request is throttled."}`),
+	}))
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{
+		Actual: 400,
+		Body:   []byte(`{"error": {"code": "RDS.0005", "message": "The instance is busy."}}`),
+	}))
+	th.AssertEquals(t, false, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{
+		Actual: 400,
+		Body:   []byte(`{"error_code": "RDS.0001"}`),
+	}))
+}
+
+func TestRetryPolicyIsRetryableNonIdempotent(t *testing.T) {
+	policy := RetryPolicy{
+		RetryableStatusCodes: []int{429, 503},
+		RetryableErrorCodes:  []string{"APIGW.0308"},
+	}
+
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "GET", Actual: 503}))
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "DELETE", Actual: 503}))
+	th.AssertEquals(t, false, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "POST", Actual: 503}))
+	th.AssertEquals(t, false, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "PATCH", Actual: 503}))
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "POST", Actual: 429}))
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{
+		Method: "POST",
+		Actual: 500,
+		Body:   []byte(`{"error_code": "APIGW.0308"}`),
+	}))
+
+	policy.RetryNonIdempotent = true
+	th.AssertEquals(t, true, policy.IsRetryable(&golangsdk.ErrUnexpectedResponseCode{Method: "POST", Actual: 503}))
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		th.AssertEquals(t, `{"name": "test"}`, string(body))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxAttempts:          5,
+		InitialBackoff:       time.Millisecond,
+		MaxBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{503},
+	}
+	client := &http.Client{Transport: newRetryRoundTripper(http.DefaultTransport, policy)}
+
+	// the PUT request is idempotent, it is retried until the server responds 200
+	req, _ := http.NewRequestWithContext(context.Background(), "PUT", server.URL, strings.NewReader(`{"name": "test"}`))
+	resp, err := client.Do(req)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.AssertEquals(t, http.StatusOK, resp.StatusCode)
+	th.AssertEquals(t, 3, requests)
+
+	// the POST request is not retried with the 503 status code
+	requests = 0
+	req, _ = http.NewRequestWithContext(context.Background(), "POST", server.URL, strings.NewReader(`{"name": "test"}`))
+	resp, err = client.Do(req)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.AssertEquals(t, http.StatusServiceUnavailable, resp.StatusCode)
+	th.AssertEquals(t, 1, requests)
+}
+
+func TestBuildHTTPConfig(t *testing.T) {
+	var requests int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the certificate of the test server is signed by a private CA, which is trusted through cacert_file
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	th.AssertNoErr(t, os.WriteFile(caCertFile, caCert, 0600))
+
+	cfg := &HcsConfig{}
+	cfg.CACertFile = caCertFile
+	cfg.MaxRetries = 3
+	cfg.RetryPolicy = &RetryPolicy{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+
+	httpConfig, err := buildHTTPConfig(cfg, "hss")
+	th.AssertNoErr(t, err)
+	client := &http.Client{Transport: httpConfig.HttpTransport}
+
+	req, _ := http.NewRequestWithContext(context.Background(), "GET", server.URL, nil)
+	resp, err := client.Do(req)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.AssertEquals(t, http.StatusOK, resp.StatusCode)
+	th.AssertEquals(t, 2, requests)
+
+	// the server is not trusted without the CA certificate
+	cfg.CACertFile = ""
+	httpConfig, err = buildHTTPConfig(cfg, "hss")
+	th.AssertNoErr(t, err)
+	client = &http.Client{Transport: httpConfig.HttpTransport}
+	req, _ = http.NewRequestWithContext(context.Background(), "GET", server.URL, nil)
+	_, err = client.Do(req)
+	th.AssertEquals(t, true, err != nil)
+}
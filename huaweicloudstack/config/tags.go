@@ -0,0 +1,36 @@
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// GetDefaultTags returns a copy of the provider-level default tags, it implements the utils.TagsConfig.
+func (c *HcsConfig) GetDefaultTags() map[string]string {
+	if c == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(c.DefaultTags))
+	for k, v := range c.DefaultTags {
+		result[k] = v
+	}
+	return result
+}
+
+// GetIgnoreTags returns the provider-level settings of the ignored tags, it implements the utils.TagsConfig.
+func (c *HcsConfig) GetIgnoreTags() utils.IgnoreTagsConfig {
+	if c == nil {
+		return utils.IgnoreTagsConfig{}
+	}
+	return c.IgnoreTags
+}
+
+// SetTagsAllDiff is a CustomizeDiffFunc which plans the computed "tags_all" attribute as the default tags of the
+// provider merged with the tags in the configuration.
+func SetTagsAllDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return utils.SetTagsAllDiff(d, GetHcsConfig(meta))
+}
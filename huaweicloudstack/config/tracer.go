@@ -0,0 +1,106 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	otlpExportTimeout = 10 * time.Second
+	otlpScopeName     = "github.com/huaweicloud/terraform-provider-hcs"
+
+	// DefaultTracingServiceName is the service.name of the exported spans.
+	DefaultTracingServiceName = "terraform-provider-hcs"
+)
+
+// Tracer records the spans with the OpenTelemetry SDK and exports them to the OTLP/HTTP receiver.
+// The spans are carried by the contexts, and they are flushed when the span of a Terraform operation ends.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+func newTracer(exporter sdktrace.SpanExporter, serviceName string) *Tracer {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, sdktrace.WithExportTimeout(otlpExportTimeout)),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceNameKey.String(serviceName))),
+	)
+	return &Tracer{
+		provider: provider,
+		tracer:   provider.Tracer(otlpScopeName),
+	}
+}
+
+// NewTracer returns a tracer which exports the spans to the endpoint, such as http://localhost:4318/v1/traces.
+func NewTracer(endpoint string, headers map[string]string, serviceName string) (*Tracer, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid tracing endpoint %q, it should be a URL like http://localhost:4318/v1/traces",
+			endpoint)
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(parsed.Host),
+		otlptracehttp.WithURLPath(parsed.Path),
+		otlptracehttp.WithHeaders(headers),
+		otlptracehttp.WithTimeout(otlpExportTimeout),
+	}
+	if parsed.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the OTLP exporter: %s", err)
+	}
+	return newTracer(exporter, serviceName), nil
+}
+
+// NewTracerFromEnv returns a tracer configured by the standard environment variables of OpenTelemetry,
+// the tracing is enabled only when OTEL_TRACES_EXPORTER is "otlp", which is the same as Terraform CLI.
+// The endpoint and headers are read from the OTEL_EXPORTER_OTLP_* variables by the OTLP exporter.
+func NewTracerFromEnv() (*Tracer, error) {
+	if os.Getenv("OTEL_TRACES_EXPORTER") != "otlp" {
+		return nil, nil
+	}
+
+	var opts []otlptracehttp.Option
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		// the receiver of the default endpoint (localhost:4318) is served without TLS
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the OTLP exporter: %s", err)
+	}
+
+	serviceName := os.Getenv("OTEL_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = DefaultTracingServiceName
+	}
+	return newTracer(exporter, serviceName), nil
+}
+
+// Start starts a span which is the child of the span in the context, and returns a context which carries it.
+func (t *Tracer) Start(ctx context.Context, name string, kind trace.SpanKind) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, name, trace.WithSpanKind(kind))
+}
+
+// Flush exports the ended spans, a failed export never fails the operations.
+func (t *Tracer) Flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+
+	if err := t.provider.ForceFlush(ctx); err != nil {
+		log.Printf("[WARN] failed to export the spans: %s", err)
+	}
+}
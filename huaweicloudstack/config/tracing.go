@@ -0,0 +1,327 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+)
+
+type serviceContextKey struct{}
+
+type operationContextKey struct{}
+
+// resourceOperation is the Terraform operation which sends the requests, it is carried by the context of them.
+type resourceOperation struct {
+	typeName   string
+	dataSource bool
+	name       string
+	d          *schema.ResourceData
+
+	// auditLock protects the audit records of the requests which are sent before the ID of the new resource is set,
+	// they are written when the operation ends.
+	auditLock     sync.Mutex
+	pendingAudits []AuditRecord
+}
+
+// address returns the address of the resource in the audit log. Terraform does not pass the name of the resource
+// block to the providers, so the address is composed of the type and the ID of the resource, such as hcs_vpc.{id},
+// and the data sources are addressed by the type, such as data.hcs_vpcs.
+func (op *resourceOperation) address(resourceID string) string {
+	if op.dataSource {
+		return "data." + op.typeName
+	}
+	if resourceID == "" {
+		return op.typeName
+	}
+	return op.typeName + "." + resourceID
+}
+
+func operationFromContext(ctx context.Context) *resourceOperation {
+	op, _ := ctx.Value(operationContextKey{}).(*resourceOperation)
+	return op
+}
+
+// TracingRoundTripper satisfies the http.RoundTripper interface and is used to record a span for each API request,
+// the span is the child of the span of the Terraform operation which sends the request.
+type TracingRoundTripper struct {
+	Rt     http.RoundTripper
+	Config *HcsConfig
+}
+
+// RoundTrip records the span of the request and performs a round-trip HTTP request.
+func (trt *TracingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	tracer := trt.Config.Tracer
+	if tracer == nil {
+		return trt.Rt.RoundTrip(request)
+	}
+
+	ctx, span := tracer.Start(request.Context(), "HTTP "+request.Method, trace.SpanKindClient)
+	defer span.End()
+
+	srv, _ := ctx.Value(serviceContextKey{}).(string)
+	if srv == "" {
+		srv = trt.Config.getServiceByHost(request.URL.Host)
+	}
+	span.SetAttributes(
+		attribute.String("hcs.service", srv),
+		attribute.String("http.method", request.Method),
+		attribute.String("http.url", redactURL(request.URL)),
+		attribute.String("http.request.headers", FormatHeaders(request.Header, "\n")),
+	)
+
+	response, err := trt.Rt.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return response, err
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", response.StatusCode))
+	if requestID := getRequestID(response.Header); requestID != "" {
+		span.SetAttributes(attribute.String("hcs.request_id", requestID))
+	}
+
+	if response.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)))
+		if strings.HasPrefix(response.Header.Get("Content-Type"), "application/json") {
+			var bs bytes.Buffer
+			if _, err := io.Copy(&bs, response.Body); err == nil {
+				span.SetAttributes(attribute.String("http.response.body", formatJSON(bs.Bytes(), true)))
+			}
+			response.Body.Close()
+			response.Body = io.NopCloser(&bs)
+		}
+	}
+	return response, nil
+}
+
+// getRequestID returns the request ID of the response, the header name differs between the services.
+func getRequestID(header http.Header) string {
+	for _, name := range []string{"X-Request-Id", "X-Openstack-Request-Id", "X-Compute-Request-Id"} {
+		if requestID := header.Get(name); requestID != "" {
+			return requestID
+		}
+	}
+	return ""
+}
+
+// recordRetries records the number of the connection retries to the span of the request.
+func recordRetries(request *http.Request, retries int) {
+	trace.SpanFromContext(request.Context()).SetAttributes(attribute.Int("http.retry_count", retries))
+}
+
+// getServiceByHost returns the first service catalog (in alphabetical order) whose endpoint matches the host,
+// it is used for the requests sent by the clients which are not created by NewServiceClient.
+func (c *HcsConfig) getServiceByHost(host string) string {
+	services := make([]string, 0, len(allServiceCatalog))
+	for srv := range allServiceCatalog {
+		services = append(services, srv)
+	}
+	sort.Strings(services)
+
+	for _, srv := range services {
+		if c.isServiceHost(srv, host) {
+			return srv
+		}
+	}
+	if c.isIdentityHost(host) {
+		return "iam"
+	}
+	return ""
+}
+
+// redactURL returns the URL without the user info, the values of the sensitive query parameters are masked.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+
+	query := redacted.Query()
+	fields := make(map[string]interface{}, len(query))
+	for k, v := range query {
+		fields[k] = strings.Join(v, ",")
+	}
+	maskSecurityFields(fields)
+	for k, v := range fields {
+		if masked := v.(string); masked != strings.Join(query[k], ",") {
+			query.Set(k, masked)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// withTraceContext returns a copy of the config for a single Terraform operation, the service clients created by
+// the copy send the requests with the context which carries the span and the operation, so the spans of the
+// requests are the children of the operation. The credentials, clients and project IDs are still read from the
+// config of the provider, so the copy only carries the context.
+func (c *HcsConfig) withTraceContext(ctx context.Context) *HcsConfig {
+	root := c
+	if c.parent != nil {
+		root = c.parent
+	}
+
+	if root.SecurityKeyLock != nil {
+		root.SecurityKeyLock.Lock()
+		defer root.SecurityKeyLock.Unlock()
+	}
+	clone := *root
+	clone.parent = root
+	clone.traceContext = ctx
+	clone.Metadata = &clone
+	return &clone
+}
+
+// withTraceClient returns a copy of the ProviderClient which sends the requests with the trace context,
+// the service catalog is carried by the context to be recorded in the spans of the requests.
+func (c *HcsConfig) withTraceClient(client *golangsdk.ProviderClient, srv string) *golangsdk.ProviderClient {
+	if c.traceContext == nil {
+		return client
+	}
+
+	clone := cloneProviderClient(client)
+	clone.Context = context.WithValue(c.traceContext, serviceContextKey{}, srv)
+	return clone
+}
+
+// TraceResource wraps the CRUD functions of the resource to record a span for each Terraform operation when the
+// tracing is enabled, and to record the operation in the audit log.
+func TraceResource(typeName string, r *schema.Resource) {
+	traceResource(typeName, false, r)
+}
+
+// TraceDataSource wraps the read function of the data source in the same way as TraceResource.
+func TraceDataSource(typeName string, r *schema.Resource) {
+	traceResource(typeName, true, r)
+}
+
+func traceResource(typeName string, dataSource bool, r *schema.Resource) {
+	if r.CreateContext != nil {
+		r.CreateContext = traceContextFunc(typeName, dataSource, "create", r.CreateContext)
+	}
+	if r.CreateWithoutTimeout != nil {
+		r.CreateWithoutTimeout = traceContextFunc(typeName, dataSource, "create", r.CreateWithoutTimeout)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = traceContextFunc(typeName, dataSource, "read", r.ReadContext)
+	}
+	if r.ReadWithoutTimeout != nil {
+		r.ReadWithoutTimeout = traceContextFunc(typeName, dataSource, "read", r.ReadWithoutTimeout)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = traceContextFunc(typeName, dataSource, "update", r.UpdateContext)
+	}
+	if r.UpdateWithoutTimeout != nil {
+		r.UpdateWithoutTimeout = traceContextFunc(typeName, dataSource, "update", r.UpdateWithoutTimeout)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = traceContextFunc(typeName, dataSource, "delete", r.DeleteContext)
+	}
+	if r.DeleteWithoutTimeout != nil {
+		r.DeleteWithoutTimeout = traceContextFunc(typeName, dataSource, "delete", r.DeleteWithoutTimeout)
+	}
+
+	//nolint:staticcheck // the deprecated functions are still used by some resources
+	if r.Create != nil {
+		r.Create = traceFunc(typeName, dataSource, "create", r.Create)
+	}
+	//nolint:staticcheck
+	if r.Read != nil {
+		r.Read = traceFunc(typeName, dataSource, "read", r.Read)
+	}
+	//nolint:staticcheck
+	if r.Update != nil {
+		r.Update = traceFunc(typeName, dataSource, "update", r.Update)
+	}
+	//nolint:staticcheck
+	if r.Delete != nil {
+		r.Delete = traceFunc(typeName, dataSource, "delete", r.Delete)
+	}
+}
+
+type contextOperationFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+type operationFunc = func(*schema.ResourceData, interface{}) error
+
+func traceContextFunc(typeName string, dataSource bool, operation string,
+	f contextOperationFunc) contextOperationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return traceOperation(ctx, &resourceOperation{typeName: typeName, dataSource: dataSource, name: operation, d: d},
+			meta,
+			func(ctx context.Context, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, meta)
+			})
+	}
+}
+
+func traceFunc(typeName string, dataSource bool, operation string, f operationFunc) operationFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		var err error
+		traceOperation(context.Background(),
+			&resourceOperation{typeName: typeName, dataSource: dataSource, name: operation, d: d}, meta,
+			func(_ context.Context, meta interface{}) diag.Diagnostics {
+				err = f(d, meta)
+				return diag.FromErr(err)
+			})
+		return err
+	}
+}
+
+// traceOperation records the span of the Terraform operation, and makes the operation known to the audit log.
+// The span and the operation are carried by the context passed to the operation. The requests are sent with a
+// context which only carries them, so the timeouts and cancellation of the operation behave the same as without
+// tracing.
+func traceOperation(ctx context.Context, op *resourceOperation, meta interface{},
+	f func(ctx context.Context, meta interface{}) diag.Diagnostics) diag.Diagnostics {
+	cfg := GetHcsConfig(meta)
+	if cfg == nil || (cfg.Tracer == nil && cfg.AuditLogger == nil) {
+		return f(ctx, meta)
+	}
+
+	if cfg.AuditLogger != nil {
+		defer op.writePendingAudits(cfg.AuditLogger)
+	}
+	ctx = context.WithValue(ctx, operationContextKey{}, op)
+	requestContext := context.WithValue(context.Background(), operationContextKey{}, op)
+	if cfg.Tracer == nil {
+		return f(ctx, &cfg.withTraceContext(requestContext).Config)
+	}
+
+	ctx, span := cfg.Tracer.Start(ctx, fmt.Sprintf("%s.%s", op.typeName, op.name), trace.SpanKindInternal)
+	defer cfg.Tracer.Flush()
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("terraform.resource_type", op.typeName),
+		attribute.String("terraform.operation", op.name),
+	)
+
+	// the ID is empty before creating and after deleting
+	resourceID := op.d.Id()
+	diags := f(ctx, &cfg.withTraceContext(trace.ContextWithSpan(requestContext, span)).Config)
+	if resourceID == "" {
+		resourceID = op.d.Id()
+	}
+	if resourceID != "" {
+		span.SetAttributes(attribute.String("terraform.resource_id", resourceID))
+	}
+	for _, v := range diags {
+		if v.Severity == diag.Error {
+			span.SetStatus(codes.Error, v.Summary)
+			break
+		}
+	}
+	return diags
+}
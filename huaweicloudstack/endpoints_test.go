@@ -838,6 +838,15 @@ func TestAccServiceEndpoints_Network(t *testing.T) {
 	actualURL = serviceClient.ResourceBaseURL()
 	compareURL(expectedURL, actualURL, "nat", "v2.0", t)
 
+	// test endpoint of private nat gateway v3
+	serviceClient, err = cfg.NatV3Client(HCS_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating HuaweiCloudStack nat gateway v3 client: %s", err)
+	}
+	expectedURL = fmt.Sprintf("https://nat.%s.%s/v3/%s/", HCS_REGION_NAME, cfg.Cloud, cfg.TenantID)
+	actualURL = serviceClient.ResourceBaseURL()
+	compareURL(expectedURL, actualURL, "nat", "v3", t)
+
 	// test endpoint of elb v2.0
 	serviceClient, err = cfg.ElbV2Client(HCS_REGION_NAME)
 	if err != nil {
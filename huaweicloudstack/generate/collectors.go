@@ -0,0 +1,521 @@
+package generate
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/recordsets"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/zones"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/cloudservers"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/elb/v3/loadbalancers"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/evs/v2/cloudvolumes"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v2/gateways"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/eips"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/security/securitygroups"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/subnets"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/vpcs"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+// collector lists the resources of a service in the region and builds the blocks of them.
+type collector func(cfg *config.HcsConfig, region string) ([]*block, error)
+
+// Services are the services supported by the generate command, the resources are written in this order, so the
+// referenced resources are written before the resources referencing them.
+var Services = []string{"vpc", "subnet", "secgroup", "eip", "ecs", "evs", "elb", "nat", "dns"}
+
+var collectors = map[string]collector{
+	"vpc":      collectVpcs,
+	"subnet":   collectSubnets,
+	"secgroup": collectSecGroups,
+	"eip":      collectEips,
+	"ecs":      collectEcsInstances,
+	"evs":      collectEvsVolumes,
+	"elb":      collectLoadBalancers,
+	"nat":      collectNatGateways,
+	"dns":      collectDNSZones,
+}
+
+// optional appends the attribute if the value is not empty, the empty values are omitted from the skeleton.
+func optional(attrs []attr, name string, value interface{}) []attr {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return attrs
+		}
+	case int:
+		if v == 0 {
+			return attrs
+		}
+	case []string:
+		if len(v) == 0 {
+			return attrs
+		}
+	case []ref:
+		if len(v) == 0 {
+			return attrs
+		}
+	}
+	return append(attrs, attr{name: name, value: value})
+}
+
+func collectVpcs(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC client: %s", err)
+	}
+	list, err := vpcs.List(client, vpcs.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying the VPCs: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "cidr", value: v.CIDR},
+		}
+		blocks = append(blocks, &block{
+			typeName: "hcs_vpc",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    optional(attrs, "description", v.Description),
+		})
+	}
+	return blocks, nil
+}
+
+func listSubnets(cfg *config.HcsConfig, region string) ([]subnets.Subnet, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC client: %s", err)
+	}
+	list, err := subnets.List(client, subnets.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying the subnets: %s", err)
+	}
+	return list, nil
+}
+
+func collectSubnets(cfg *config.HcsConfig, region string) ([]*block, error) {
+	list, err := listSubnets(cfg, region)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "cidr", value: v.CIDR},
+			{name: "gateway_ip", value: v.GatewayIP},
+			{name: "vpc_id", value: refID("hcs_vpc", v.VPC_ID)},
+		}
+		attrs = optional(attrs, "availability_zone", v.AvailabilityZone)
+		blocks = append(blocks, &block{
+			typeName: "hcs_vpc_subnet",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    attrs,
+			aliases:  map[string]string{"ipv4_subnet_id": v.SubnetId},
+		})
+	}
+	return blocks, nil
+}
+
+func collectSecGroups(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC client: %s", err)
+	}
+	pages, err := securitygroups.List(client, securitygroups.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the security groups: %s", err)
+	}
+	list, err := securitygroups.ExtractSecurityGroups(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the security groups: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+		}
+		blocks = append(blocks, &block{
+			typeName: "hcs_networking_secgroup",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    optional(attrs, "description", v.Description),
+		})
+	}
+	return blocks, nil
+}
+
+func collectEips(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC client: %s", err)
+	}
+	pages, err := eips.List(client, eips.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the EIPs: %s", err)
+	}
+	list, err := eips.ExtractPublicIPs(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the EIPs: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		bandwidth := []attr{
+			{name: "share_type", value: v.BandwidthShareType},
+		}
+		bandwidth = optional(bandwidth, "name", v.BandwidthName)
+		bandwidth = optional(bandwidth, "size", v.BandwidthSize)
+		blocks = append(blocks, &block{
+			typeName: "hcs_vpc_eip",
+			id:       v.ID,
+			name:     v.PublicAddress,
+			attrs: []attr{
+				{name: "publicip", value: []attr{{name: "type", value: v.Type}}},
+				{name: "bandwidth", value: bandwidth},
+			},
+		})
+	}
+	return blocks, nil
+}
+
+// findSubnet returns the ID of the subnet in the VPC whose CIDR contains the address.
+func findSubnet(list []subnets.Subnet, vpcID, address string) string {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return ""
+	}
+	for _, v := range list {
+		if v.VPC_ID != vpcID {
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(v.CIDR); err == nil && cidr.Contains(ip) {
+			return v.ID
+		}
+	}
+	return ""
+}
+
+func collectEcsInstances(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+	pages, err := cloudservers.List(client, cloudservers.ListOpts{Offset: 1, Limit: 100}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the ECS instances: %s", err)
+	}
+	list, err := cloudservers.ExtractServers(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the ECS instances: %s", err)
+	}
+
+	// the addresses of the instances only contain the IPs, the networks are found by the CIDRs of the subnets
+	subnetList, err := listSubnets(cfg, region)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "image_id", value: v.Image.ID},
+			{name: "flavor_id", value: v.Flavor.ID},
+		}
+		attrs = optional(attrs, "availability_zone", v.AvailabilityZone)
+		attrs = optional(attrs, "key_pair", v.KeyName)
+
+		secGroups := make([]ref, 0, len(v.SecurityGroups))
+		for _, sg := range v.SecurityGroups {
+			if sg.ID != "" {
+				secGroups = append(secGroups, refID("hcs_networking_secgroup", sg.ID))
+			}
+		}
+		attrs = optional(attrs, "security_group_ids", secGroups)
+
+		for _, vpcID := range sortedAddressKeys(v.Addresses) {
+			for _, address := range v.Addresses[vpcID] {
+				if address.Type == "floating" {
+					continue
+				}
+				subnetID := findSubnet(subnetList, vpcID, address.Addr)
+				if subnetID == "" {
+					log.Printf("[WARN] the subnet of the address %s of the instance %s is not found", address.Addr, v.ID)
+					continue
+				}
+				attrs = append(attrs, attr{name: "network", value: []attr{
+					{name: "uuid", value: refID("hcs_vpc_subnet", subnetID)},
+				}})
+			}
+		}
+
+		blocks = append(blocks, &block{
+			typeName: "hcs_ecs_compute_instance",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func sortedAddressKeys(m map[string][]cloudservers.Address) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func collectEvsVolumes(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.BlockStorageV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EVS client: %s", err)
+	}
+	pages, err := cloudvolumes.List(client, cloudvolumes.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the EVS volumes: %s", err)
+	}
+	list, err := cloudvolumes.ExtractVolumes(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the EVS volumes: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		// the system disks are managed by the instances
+		if v.Bootable == "true" && len(v.Attachments) > 0 {
+			continue
+		}
+
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "size", value: v.Size},
+			{name: "volume_type", value: v.VolumeType},
+			{name: "availability_zone", value: v.AvailabilityZone},
+		}
+		blocks = append(blocks, &block{
+			typeName: "hcs_evs_volume",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    optional(attrs, "description", v.Description),
+		})
+	}
+	return blocks, nil
+}
+
+// @API ELB GET /v3/{project_id}/elb/loadbalancers
+func listLoadBalancers(client *golangsdk.ServiceClient) ([]loadbalancers.LoadBalancer, error) {
+	listPath := client.Endpoint + "v3/{project_id}/elb/loadbalancers?limit=100"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+
+	var result []loadbalancers.LoadBalancer
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath += "&marker=" + marker
+		}
+
+		var resp struct {
+			LoadBalancers []loadbalancers.LoadBalancer `json:"loadbalancers"`
+			PageInfo      struct {
+				NextMarker string `json:"next_marker"`
+			} `json:"page_info"`
+		}
+		_, err := client.Get(currentPath, &resp, &golangsdk.RequestOpts{
+			MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resp.LoadBalancers...)
+
+		marker = resp.PageInfo.NextMarker
+		if marker == "" || len(resp.LoadBalancers) == 0 {
+			return result, nil
+		}
+	}
+}
+
+func collectLoadBalancers(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.ElbV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+	list, err := listLoadBalancers(client)
+	if err != nil {
+		return nil, fmt.Errorf("error querying the ELB load balancers: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "vpc_id", value: refID("hcs_vpc", v.VpcID)},
+		}
+		if v.VipSubnetCidrID != "" {
+			attrs = append(attrs, attr{name: "ipv4_subnet_id", value: ref{
+				typeName: "hcs_vpc_subnet",
+				attr:     "ipv4_subnet_id",
+				value:    v.VipSubnetCidrID,
+			}})
+		}
+		attrs = optional(attrs, "availability_zone", v.AvailabilityZoneList)
+		attrs = optional(attrs, "l4_flavor_id", v.L4FlavorID)
+		attrs = optional(attrs, "l7_flavor_id", v.L7FlavorID)
+		attrs = optional(attrs, "description", v.Description)
+		blocks = append(blocks, &block{
+			typeName: "hcs_elb_loadbalancer",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func collectNatGateways(cfg *config.HcsConfig, region string) ([]*block, error) {
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT client: %s", err)
+	}
+	list, err := gateways.List(client, gateways.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying the NAT gateways: %s", err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "spec", value: v.Spec},
+			{name: "vpc_id", value: refID("hcs_vpc", v.RouterId)},
+			{name: "subnet_id", value: refID("hcs_vpc_subnet", v.InternalNetworkId)},
+		}
+		blocks = append(blocks, &block{
+			typeName: "hcs_nat_gateway",
+			id:       v.ID,
+			name:     v.Name,
+			attrs:    optional(attrs, "description", v.Description),
+		})
+	}
+	return blocks, nil
+}
+
+func listDNSZones(client *golangsdk.ServiceClient, zoneType string) ([]zones.Zone, error) {
+	pages, err := zones.List(client, zones.ListOpts{Type: zoneType}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the DNS %s zones: %s", zoneType, err)
+	}
+	list, err := zones.ExtractZones(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the DNS %s zones: %s", zoneType, err)
+	}
+	return list, nil
+}
+
+// collectDNSZones collects the public and private zones, and the record sets of them.
+func collectDNSZones(cfg *config.HcsConfig, region string) ([]*block, error) {
+	publicClient, err := cfg.DnsV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS client: %s", err)
+	}
+	privateClient, err := cfg.DnsWithRegionClient(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS region client: %s", err)
+	}
+	recordsetClient, err := cfg.NewServiceClient("dns_region", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS region client: %s", err)
+	}
+
+	publicZones, err := listDNSZones(publicClient, "public")
+	if err != nil {
+		return nil, err
+	}
+	privateZones, err := listDNSZones(privateClient, "private")
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*block
+	for _, v := range append(publicZones, privateZones...) {
+		attrs := []attr{
+			{name: "name", value: v.Name},
+			{name: "zone_type", value: v.ZoneType},
+		}
+		attrs = optional(attrs, "email", v.Email)
+		attrs = optional(attrs, "ttl", v.TTL)
+		attrs = optional(attrs, "description", v.Description)
+		for _, router := range v.Routers {
+			attrs = append(attrs, attr{name: "router", value: []attr{
+				{name: "router_id", value: refID("hcs_vpc", router.RouterID)},
+				{name: "router_region", value: router.RouterRegion},
+			}})
+		}
+		blocks = append(blocks, &block{
+			typeName: "hcs_dns_zone",
+			id:       v.ID,
+			name:     strings.TrimSuffix(v.Name, "."),
+			attrs:    attrs,
+		})
+
+		recordBlocks, err := collectDNSRecordsets(recordsetClient, v.ID)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, recordBlocks...)
+	}
+	return blocks, nil
+}
+
+func collectDNSRecordsets(client *golangsdk.ServiceClient, zoneID string) ([]*block, error) {
+	pages, err := recordsets.ListByZone(client, zoneID, recordsets.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying the record sets of the DNS zone (%s): %s", zoneID, err)
+	}
+	list, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting the record sets of the DNS zone (%s): %s", zoneID, err)
+	}
+
+	blocks := make([]*block, 0, len(list))
+	for _, v := range list {
+		// the SOA and NS record sets are created with the zone
+		if v.Default {
+			continue
+		}
+
+		attrs := []attr{
+			{name: "zone_id", value: refID("hcs_dns_zone", zoneID)},
+			{name: "name", value: v.Name},
+			{name: "type", value: v.Type},
+			{name: "records", value: v.Records},
+		}
+		attrs = optional(attrs, "ttl", v.TTL)
+		attrs = optional(attrs, "description", v.Description)
+		blocks = append(blocks, &block{
+			typeName: "hcs_dns_recordset",
+			id:       v.ID,
+			importID: fmt.Sprintf("%s/%s", zoneID, v.ID),
+			name:     strings.TrimSuffix(v.Name, ".") + "_" + strings.ToLower(v.Type),
+			attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
@@ -0,0 +1,114 @@
+// Package generate implements the generate command of the provider binary, which walks the resources of a project
+// through the list APIs and writes the import blocks and the skeleton configuration of them.
+package generate
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+const (
+	importsFile   = "imports.tf"
+	resourcesFile = "resources.tf"
+)
+
+// Run parses the arguments of the generate command, then writes the import blocks and the resource blocks of the
+// project into the output directory. The provider is configured by the HCS_* environment variables, the region and
+// the project can be overridden by the arguments.
+func Run(p *schema.Provider, args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	region := flags.String("region", "", "The region of the resources, defaults to HCS_REGION_NAME.")
+	projectName := flags.String("project-name", "", "The project of the resources, defaults to HCS_PROJECT_NAME.")
+	services := flags.String("services", strings.Join(Services, ","),
+		"The comma separated services of the resources.")
+	output := flags.String("output", ".", "The directory to write the "+importsFile+" and "+resourcesFile+" into.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	serviceList, err := parseServices(*services)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{importsFile, resourcesFile} {
+		if _, err := os.Stat(filepath.Join(*output, name)); err == nil {
+			return fmt.Errorf("the file %s already exists in %s", name, *output)
+		}
+	}
+
+	raw := make(map[string]interface{})
+	if *region != "" {
+		raw["region"] = *region
+	}
+	if *projectName != "" {
+		raw["project_name"] = *projectName
+	}
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		for _, d := range diags {
+			if d.Detail != "" {
+				return fmt.Errorf("error configuring the provider: %s: %s", d.Summary, d.Detail)
+			}
+			return fmt.Errorf("error configuring the provider: %s", d.Summary)
+		}
+	}
+	cfg := config.GetHcsConfig(p.Meta())
+
+	var blocks []*block
+	for _, service := range serviceList {
+		serviceBlocks, err := collectors[service](cfg, cfg.Region)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "found %d resources of %s\n", len(serviceBlocks), service)
+		blocks = append(blocks, serviceBlocks...)
+	}
+
+	w := newWriter(blocks)
+	var imports, resources bytes.Buffer
+	w.WriteImports(&imports)
+	w.WriteResources(&resources)
+	if err := os.MkdirAll(*output, 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(*output, importsFile), imports.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(*output, resourcesFile), resources.Bytes(), 0644)
+}
+
+// parseServices returns the services in the order of Services, so the referenced resources are written first.
+func parseServices(value string) ([]string, error) {
+	selected := make(map[string]bool)
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if _, ok := collectors[v]; !ok {
+			return nil, fmt.Errorf("the service %s is not supported, the valid values are: %s", v,
+				strings.Join(Services, ", "))
+		}
+		selected[v] = true
+	}
+
+	result := make([]string, 0, len(selected))
+	for _, v := range Services {
+		if selected[v] {
+			result = append(result, v)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("at least one service is required")
+	}
+	return result, nil
+}
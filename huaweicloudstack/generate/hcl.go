@@ -0,0 +1,219 @@
+package generate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// block is a resource found in the tenant, it is written as a resource block and an import block.
+type block struct {
+	typeName string
+	id       string
+	// importID is the ID used to import the resource, defaults to id.
+	importID string
+	// name is used to build the label of the resource block.
+	name  string
+	attrs []attr
+	// aliases are the other IDs of the resource which can be referenced, keyed by the attribute name, such as the
+	// ipv4_subnet_id of a subnet.
+	aliases map[string]string
+
+	label string
+}
+
+// attr is an argument or a nested block of the resource block, the value is one of string, int, bool, []string,
+// ref, []ref and []attr (a nested block).
+type attr struct {
+	name  string
+	value interface{}
+}
+
+// ref is a reference to the attribute of another resource, it is written as a literal value if the resource is not
+// generated.
+type ref struct {
+	typeName string
+	attr     string
+	value    string
+}
+
+func refID(typeName, id string) ref {
+	return ref{typeName: typeName, attr: "id", value: id}
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// buildLabel returns a valid and unique label of the resource, the label is built from the name of the resource or
+// its ID if the name is empty.
+func buildLabel(b *block, used map[string]bool) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(b.name), "_"), "_")
+	if label == "" {
+		label = strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(b.id), "_"), "_")
+	}
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+
+	key := b.typeName + "." + label
+	for i := 2; used[key]; i++ {
+		key = fmt.Sprintf("%s.%s_%d", b.typeName, label, i)
+	}
+	used[key] = true
+	return strings.TrimPrefix(key, b.typeName+".")
+}
+
+// hclString returns the quoted string with the escape sequences of HCL, the template sequences are escaped so the
+// value is written literally. HCL does not support the escapes of Go such as \a and \x00, so the control characters
+// are written as \uNNNN, and the invalid UTF-8 bytes are written as the replacement character.
+func hclString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		switch {
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i:], "{"):
+			// $${ and %%{ are the literal ${ and %{
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case r == utf8.RuneError && size == 1:
+			sb.WriteString(`\uFFFD`)
+		case r > 0xFFFF && !unicode.IsPrint(r):
+			fmt.Fprintf(&sb, `\U%08X`, r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+type writer struct {
+	blocks []*block
+	// index maps the referenced IDs to the addresses of the resources
+	index map[string]string
+}
+
+func newWriter(blocks []*block) *writer {
+	w := &writer{
+		blocks: blocks,
+		index:  make(map[string]string),
+	}
+	used := make(map[string]bool)
+	for _, b := range blocks {
+		b.label = buildLabel(b, used)
+		address := b.typeName + "." + b.label
+		w.index[b.typeName+"/id/"+b.id] = address
+		for name, v := range b.aliases {
+			w.index[b.typeName+"/"+name+"/"+v] = address
+		}
+	}
+	return w
+}
+
+func (w *writer) expression(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return hclString(v)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case ref:
+		if address, ok := w.index[v.typeName+"/"+v.attr+"/"+v.value]; ok {
+			return address + "." + v.attr
+		}
+		return hclString(v.value)
+	case []string:
+		items := make([]string, len(v))
+		for i, s := range v {
+			items[i] = hclString(s)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case []ref:
+		items := make([]string, len(v))
+		for i, r := range v {
+			items[i] = w.expression(r)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	panic(fmt.Sprintf("unsupported value type %T", value))
+}
+
+func isNested(a attr) bool {
+	_, ok := a.value.([]attr)
+	return ok
+}
+
+// writeAttrs writes the arguments and the nested blocks, the equals signs of the consecutive arguments are aligned
+// as terraform fmt does.
+func (w *writer) writeAttrs(out io.Writer, attrs []attr, indent string) {
+	width := 0
+	for i, a := range attrs {
+		if nested, ok := a.value.([]attr); ok {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			fmt.Fprintf(out, "%s%s {\n", indent, a.name)
+			w.writeAttrs(out, nested, indent+"  ")
+			fmt.Fprintf(out, "%s}\n", indent)
+			continue
+		}
+
+		if i == 0 || isNested(attrs[i-1]) {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			width = 0
+			for j := i; j < len(attrs) && !isNested(attrs[j]); j++ {
+				if len(attrs[j].name) > width {
+					width = len(attrs[j].name)
+				}
+			}
+		}
+		fmt.Fprintf(out, "%s%-*s = %s\n", indent, width, a.name, w.expression(a.value))
+	}
+}
+
+// WriteResources writes the resource blocks.
+func (w *writer) WriteResources(out io.Writer) {
+	for i, b := range w.blocks {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "resource %q %q {\n", b.typeName, b.label)
+		w.writeAttrs(out, b.attrs, "  ")
+		fmt.Fprintln(out, "}")
+	}
+}
+
+// WriteImports writes the import blocks which are supported since Terraform 1.5.
+func (w *writer) WriteImports(out io.Writer) {
+	for i, b := range w.blocks {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		importID := b.importID
+		if importID == "" {
+			importID = b.id
+		}
+		fmt.Fprintf(out, "import {\n  to = %s.%s\n  id = %s\n}\n", b.typeName, b.label, hclString(importID))
+	}
+}
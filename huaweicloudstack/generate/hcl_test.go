@@ -0,0 +1,133 @@
+package generate
+
+import (
+	"bytes"
+	"testing"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestWriter(t *testing.T) {
+	blocks := []*block{
+		{
+			typeName: "hcs_vpc",
+			id:       "vpc-id",
+			name:     "My VPC",
+			attrs: []attr{
+				{name: "name", value: "My VPC"},
+				{name: "cidr", value: "192.168.0.0/16"},
+			},
+		},
+		{
+			typeName: "hcs_vpc_subnet",
+			id:       "subnet-id",
+			name:     "1-subnet",
+			attrs: []attr{
+				{name: "name", value: "${subnet}"},
+				{name: "vpc_id", value: refID("hcs_vpc", "vpc-id")},
+			},
+			aliases: map[string]string{"ipv4_subnet_id": "ipv4-id"},
+		},
+		{
+			typeName: "hcs_vpc_subnet",
+			id:       "subnet-id-2",
+			name:     "1-subnet",
+			attrs: []attr{
+				{name: "vpc_id", value: refID("hcs_vpc", "unknown-vpc-id")},
+			},
+		},
+		{
+			typeName: "hcs_elb_loadbalancer",
+			id:       "elb-id",
+			attrs: []attr{
+				{name: "ipv4_subnet_id", value: ref{typeName: "hcs_vpc_subnet", attr: "ipv4_subnet_id", value: "ipv4-id"}},
+				{name: "availability_zone", value: []string{"az1", "az2"}},
+				{name: "network", value: []attr{{name: "uuid", value: refID("hcs_vpc_subnet", "subnet-id")}}},
+				{name: "size", value: 10},
+			},
+		},
+		{
+			typeName: "hcs_dns_recordset",
+			id:       "recordset-id",
+			importID: "zone-id/recordset-id",
+			name:     "www.example.com_a",
+		},
+	}
+
+	w := newWriter(blocks)
+	var resources, imports bytes.Buffer
+	w.WriteResources(&resources)
+	w.WriteImports(&imports)
+
+	th.AssertEquals(t, `resource "hcs_vpc" "my_vpc" {
+  name = "My VPC"
+  cidr = "192.168.0.0/16"
+}
+
+resource "hcs_vpc_subnet" "r_1_subnet" {
+  name   = "$${subnet}"
+  vpc_id = hcs_vpc.my_vpc.id
+}
+
+resource "hcs_vpc_subnet" "r_1_subnet_2" {
+  vpc_id = "unknown-vpc-id"
+}
+
+resource "hcs_elb_loadbalancer" "elb_id" {
+  ipv4_subnet_id    = hcs_vpc_subnet.r_1_subnet.ipv4_subnet_id
+  availability_zone = ["az1", "az2"]
+
+  network {
+    uuid = hcs_vpc_subnet.r_1_subnet.id
+  }
+
+  size = 10
+}
+
+resource "hcs_dns_recordset" "www_example_com_a" {
+}
+`, resources.String())
+
+	th.AssertEquals(t, `import {
+  to = hcs_vpc.my_vpc
+  id = "vpc-id"
+}
+
+import {
+  to = hcs_vpc_subnet.r_1_subnet
+  id = "subnet-id"
+}
+
+import {
+  to = hcs_vpc_subnet.r_1_subnet_2
+  id = "subnet-id-2"
+}
+
+import {
+  to = hcs_elb_loadbalancer.elb_id
+  id = "elb-id"
+}
+
+import {
+  to = hcs_dns_recordset.www_example_com_a
+  id = "zone-id/recordset-id"
+}
+`, imports.String())
+}
+
+func TestHclString(t *testing.T) {
+	cases := map[string]string{
+		"My VPC":                  `"My VPC"`,
+		`quote " and \ slash`:     `"quote \" and \\ slash"`,
+		"line\nfeed\r\ttab":       `"line\nfeed\r\ttab"`,
+		"bell\a null\x00 del\x7f": `"bell\u0007 null\u0000 del\u007F"`,
+		"invalid \xff byte":       `"invalid \uFFFD byte"`,
+		"unicode 中文 \u200b":       `"unicode 中文 \u200B"`,
+		"${var.name} %{if true}":  `"$${var.name} %%{if true}"`,
+		"$${literal} $ % {":       `"$$${literal} $ % {"`,
+		"price: 100% {discount}":  `"price: 100% {discount}"`,
+	}
+	for input, expected := range cases {
+		th.AssertEquals(t, expected, hclString(input))
+	}
+}
@@ -0,0 +1,272 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// timestamp returns the current time in the format without the time zone, which is accepted by all services.
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000000")
+}
+
+// transition is the status of the resource which changes from the pending value to the target value after a few
+// queries, such as the status of a VPC which changes from CREATING to OK.
+type transition struct {
+	field   string
+	pending string
+	target  string
+}
+
+// collection stores the resources of a type in the order of creation.
+type collection struct {
+	// key is the JSON key of a resource in the request and response bodies, the bodies are the resource itself if it
+	// is empty.
+	key string
+	// listKey is the JSON key of the resources in the response of the list API.
+	listKey string
+	status  *transition
+	// prepare is called to complete the attributes of a new resource before it is stored.
+	prepare func(id string, attrs map[string]interface{})
+
+	ids   []string
+	items map[string]*item
+}
+
+type item struct {
+	attrs        map[string]interface{}
+	pendingPolls int
+}
+
+// newCollection registers a collection of the resources with the name.
+func (s *Server) newCollection(name, key, listKey string) *collection {
+	c := &collection{
+		key:     key,
+		listKey: listKey,
+		items:   make(map[string]*item),
+	}
+	s.collections[name] = c
+	return c
+}
+
+func (c *collection) reset() {
+	c.ids = nil
+	c.items = make(map[string]*item)
+}
+
+// unwrap returns the attributes of the resource in the request body.
+func (c *collection) unwrap(body map[string]interface{}) map[string]interface{} {
+	if c.key == "" {
+		return body
+	}
+	attrs, _ := body[c.key].(map[string]interface{})
+	return attrs
+}
+
+// wrap returns the response body of the resource.
+func (c *collection) wrap(attrs map[string]interface{}) interface{} {
+	if c.key == "" {
+		return attrs
+	}
+	return map[string]interface{}{c.key: attrs}
+}
+
+// create stores a new resource with the attributes of the request and the path parameters, such as the zone ID
+// of a DNS recordset.
+func (s *Server) create(c *collection, params map[string]string, body map[string]interface{}) map[string]interface{} {
+	id := s.newID()
+	attrs := map[string]interface{}{
+		"id":         id,
+		"created_at": timestamp(),
+	}
+	for k, v := range c.unwrap(body) {
+		attrs[k] = v
+	}
+	for k, v := range params {
+		attrs[k] = v
+	}
+	if c.prepare != nil {
+		c.prepare(id, attrs)
+	}
+
+	newItem := &item{attrs: attrs}
+	if c.status != nil {
+		attrs[c.status.field] = c.status.target
+		if s.PendingPolls > 0 {
+			attrs[c.status.field] = c.status.pending
+			newItem.pendingPolls = s.PendingPolls
+		}
+	}
+
+	c.ids = append(c.ids, id)
+	c.items[id] = newItem
+	return attrs
+}
+
+// get returns the resource, the pending status changes to the target status after the configured queries.
+func (c *collection) get(id string) (map[string]interface{}, bool) {
+	v, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	if c.status != nil && v.pendingPolls > 0 {
+		v.pendingPolls--
+		if v.pendingPolls == 0 {
+			v.attrs[c.status.field] = c.status.target
+		}
+	}
+	return v.attrs, true
+}
+
+// list returns the resources whose attributes match the filters, the pagination by marker and limit is supported.
+func (c *collection) list(filters map[string]string, marker string, limit int) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	started := marker == ""
+	for _, id := range c.ids {
+		if !started {
+			started = id == marker
+			continue
+		}
+
+		attrs := c.items[id].attrs
+		if !matchFilters(attrs, filters) {
+			continue
+		}
+		result = append(result, attrs)
+		if limit > 0 && len(result) == limit {
+			break
+		}
+	}
+	return result
+}
+
+// matchFilters returns whether the attributes match the filters, the filters of the attributes which the resource
+// does not have are ignored, such as the enterprise project ID of the resources created without it.
+func matchFilters(attrs map[string]interface{}, filters map[string]string) bool {
+	for k, v := range filters {
+		if value, ok := attrs[k]; ok && fmt.Sprint(value) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// update merges the attributes of the request into the resource.
+func (c *collection) update(id string, attrs map[string]interface{}) (map[string]interface{}, bool) {
+	v, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	for k, value := range attrs {
+		v.attrs[k] = value
+	}
+	v.attrs["updated_at"] = timestamp()
+	return v.attrs, true
+}
+
+func (c *collection) delete(id string) (map[string]interface{}, bool) {
+	v, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	delete(c.items, id)
+	for i, existing := range c.ids {
+		if existing == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return v.attrs, true
+}
+
+// resourceCodes are the status codes of the responses of the create and delete APIs.
+type resourceCodes struct {
+	create int
+	delete int
+}
+
+var defaultCodes = resourceCodes{create: http.StatusOK, delete: http.StatusNoContent}
+
+// registerResources registers the create, list, get, update and delete APIs of the collection, such as
+// POST /v1/vpcs, GET /v1/vpcs, GET /v1/vpcs/{id}, PUT /v1/vpcs/{id} and DELETE /v1/vpcs/{id}.
+// The list API is registered at path + "/detail" if detail is true.
+func (s *Server) registerResources(path string, c *collection, codes resourceCodes, detail bool) {
+	s.handle("POST", path, s.createHandler(c, codes.create))
+	if detail {
+		s.handle("GET", path+"/detail", s.listHandler(c))
+	} else {
+		s.handle("GET", path, s.listHandler(c))
+	}
+	s.handle("GET", path+"/{id}", s.getHandler(c))
+	s.handle("PUT", path+"/{id}", s.updateHandler(c))
+	s.handle("DELETE", path+"/{id}", s.deleteHandler(c, codes.delete))
+}
+
+func (s *Server) createHandler(c *collection, code int) handlerFunc {
+	return func(_ *http.Request, params map[string]string, body map[string]interface{}) (int, interface{}) {
+		if c.unwrap(body) == nil {
+			return badRequest("the request body must contain %q", c.key)
+		}
+		return code, c.wrap(s.create(c, params, body))
+	}
+}
+
+func (s *Server) listHandler(c *collection) handlerFunc {
+	return func(r *http.Request, params map[string]string, _ map[string]interface{}) (int, interface{}) {
+		query := r.URL.Query()
+		filters := queryFilters(query)
+		// the path parameters of the list API are the parents of the resources, such as the zone ID
+		for k, v := range params {
+			filters[k] = v
+		}
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		resources := c.list(filters, query.Get("marker"), limit)
+		return http.StatusOK, map[string]interface{}{
+			c.listKey: resources,
+			"metadata": map[string]interface{}{
+				"total_count": len(resources),
+			},
+		}
+	}
+}
+
+func (s *Server) getHandler(c *collection) handlerFunc {
+	return func(_ *http.Request, params map[string]string, _ map[string]interface{}) (int, interface{}) {
+		attrs, ok := c.get(params["id"])
+		if !ok {
+			return notFound("the resource %s does not exist", params["id"])
+		}
+		return http.StatusOK, c.wrap(attrs)
+	}
+}
+
+func (s *Server) updateHandler(c *collection) handlerFunc {
+	return func(_ *http.Request, params map[string]string, body map[string]interface{}) (int, interface{}) {
+		attrs, ok := c.update(params["id"], c.unwrap(body))
+		if !ok {
+			return notFound("the resource %s does not exist", params["id"])
+		}
+		return http.StatusOK, c.wrap(attrs)
+	}
+}
+
+func (s *Server) deleteHandler(c *collection, code int) handlerFunc {
+	return func(_ *http.Request, params map[string]string, _ map[string]interface{}) (int, interface{}) {
+		attrs, ok := c.delete(params["id"])
+		if !ok {
+			return notFound("the resource %s does not exist", params["id"])
+		}
+		delete(s.tags, params["id"])
+		if code == http.StatusNoContent {
+			return code, nil
+		}
+		// some APIs, such as the DNS, return the deleted resource
+		return code, c.wrap(attrs)
+	}
+}
@@ -0,0 +1,293 @@
+// Package mockserver provides an in-memory fake of the HuaweiCloudStack control plane for the core services, so the
+// whole lifecycle of the resources can be tested without a live HuaweiCloudStack.
+//
+// The resources are kept in memory and the asynchronous operations, such as the status of a new VPC and the jobs of
+// the ECS, complete after a few queries. Only the APIs used by the provider are simulated, the request parameters
+// are not validated and the authentication is not checked.
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+const (
+	// ProjectID is the ID of every project returned by the IAM API of the server.
+	ProjectID = "0970dd7a1300f5672ff2c003c60ae115"
+	// DomainID is the ID of the domain returned by the IAM API of the server.
+	DomainID = "0970dd7a0f00f4a40f3fc0037aee4a4b"
+	// DomainName is the name of the domain returned by the IAM API of the server.
+	DomainName = "mock-domain"
+
+	// defaultPendingPolls is the number of the queries which return the pending status of an asynchronous operation.
+	defaultPendingPolls = 1
+)
+
+// Services are the catalog keys of the services simulated by the server, the provider should point them at the URL.
+var Services = []string{
+	"identity", "iam", "ecs", "ecsv11", "ecsv21", "evs", "evsv21", "vpc", "networkv2", "vpcv3",
+	"elbv2", "elbv3", "nat", "dns", "dns_region",
+}
+
+// handlerFunc handles the request with the path parameters, and returns the status code and the body of the response.
+type handlerFunc func(r *http.Request, params map[string]string, body map[string]interface{}) (int, interface{})
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// Server is the fake control plane, it is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, such as http://127.0.0.1:8080, it is set by Start.
+	URL string
+	// PendingPolls is the number of the queries which return the pending status before an asynchronous
+	// operation completes.
+	PendingPolls int
+
+	lock        sync.Mutex
+	routes      []route
+	collections map[string]*collection
+	jobs        map[string]*job
+	tags        map[string]map[string]string
+	counter     int
+}
+
+var (
+	startOnce sync.Once
+	started   *Server
+)
+
+// Start starts the shared server on the HTTP server of the testhelper, it is started only once in a process
+// because the testhelper has a single global server.
+func Start() *Server {
+	startOnce.Do(func() {
+		th.SetupHTTP()
+		started = New()
+		started.URL = th.Server.URL
+		th.Mux.Handle("/", started)
+	})
+	return started
+}
+
+// New returns a server which is not started, it can be served by any HTTP server.
+func New() *Server {
+	s := &Server{
+		PendingPolls: defaultPendingPolls,
+		collections:  make(map[string]*collection),
+		jobs:         make(map[string]*job),
+		tags:         make(map[string]map[string]string),
+	}
+	s.registerIdentity()
+	s.registerVPC()
+	s.registerECS()
+	s.registerEVS()
+	s.registerELB()
+	s.registerNAT()
+	s.registerDNS()
+	return s
+}
+
+// Endpoints returns the endpoints of the simulated services which can be used as the endpoints of the provider.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(Services))
+	for _, srv := range Services {
+		endpoints[srv] = s.URL + "/"
+	}
+	return endpoints
+}
+
+// AuthURL returns the identity authentication URL of the server.
+func (s *Server) AuthURL() string {
+	return s.URL + "/v3"
+}
+
+// Reset removes all resources, jobs and tags.
+func (s *Server) Reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, c := range s.collections {
+		c.reset()
+	}
+	s.jobs = make(map[string]*job)
+	s.tags = make(map[string]map[string]string)
+}
+
+// handle registers the handler of the requests, the pattern is the path without the project ID, such as
+// /v1/vpcs/{id}, and the segments in braces match any value.
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: splitPath(pattern),
+		handler:  handler,
+	})
+}
+
+// ServeHTTP dispatches the request to the handler whose pattern matches the path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	if contents, err := io.ReadAll(r.Body); err == nil && len(contents) > 0 {
+		if err := json.Unmarshal(contents, &body); err != nil {
+			code, result := badRequest("the request body is not a JSON object: %s", err)
+			writeJSON(w, code, result)
+			return
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	segments := splitPath(r.URL.Path)
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+		if params, ok := match(rt.segments, segments); ok {
+			code, result := rt.handler(r, params, body)
+			writeJSON(w, code, result)
+			return
+		}
+	}
+
+	if code, result, ok := s.handleTags(r.Method, segments, body); ok {
+		writeJSON(w, code, result)
+		return
+	}
+	code, result := notFound("the API %s %s is not simulated", r.Method, r.URL.Path)
+	writeJSON(w, code, result)
+}
+
+// splitPath returns the segments of the path, the project ID is removed so the same pattern matches the APIs
+// with and without the project ID.
+func splitPath(path string) []string {
+	var segments []string
+	for _, v := range strings.Split(path, "/") {
+		if v != "" && v != ProjectID {
+			segments = append(segments, v)
+		}
+	}
+	return segments
+}
+
+func match(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, v := range pattern {
+		if strings.HasPrefix(v, "{") && strings.HasSuffix(v, "}") {
+			params[strings.Trim(v, "{}")] = segments[i]
+			continue
+		}
+		if v != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeJSON(w http.ResponseWriter, code int, result interface{}) {
+	w.Header().Set("X-Request-Id", fmt.Sprintf("mock-%d", code))
+	if result == nil {
+		w.WriteHeader(code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(result) //nolint:errcheck
+}
+
+func apiError(code int, errorCode, format string, a ...interface{}) (int, interface{}) {
+	return code, map[string]interface{}{
+		"error_code": errorCode,
+		"error_msg":  fmt.Sprintf(format, a...),
+	}
+}
+
+func notFound(format string, a ...interface{}) (int, interface{}) {
+	return apiError(http.StatusNotFound, "MOCK.0404", format, a...)
+}
+
+func badRequest(format string, a ...interface{}) (int, interface{}) {
+	return apiError(http.StatusBadRequest, "MOCK.0400", format, a...)
+}
+
+// newID returns a unique ID in the format of UUID.
+func (s *Server) newID() string {
+	s.counter++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.counter, s.counter)
+}
+
+// handleTags handles the tag APIs of all resources, such as GET {resource_type}/{id}/tags and
+// POST {resource_type}/{id}/tags/action.
+func (s *Server) handleTags(method string, segments []string, body map[string]interface{}) (int, interface{}, bool) {
+	n := len(segments)
+	switch {
+	case method == "GET" && n >= 3 && segments[n-1] == "tags":
+		code, result := s.getTags(segments[n-2])
+		return code, result, true
+	case method == "POST" && n >= 4 && segments[n-2] == "tags" && segments[n-1] == "action":
+		code, result := s.updateTags(segments[n-3], body)
+		return code, result, true
+	}
+	return 0, nil, false
+}
+
+func (s *Server) getTags(resourceID string) (int, interface{}) {
+	tags := make([]map[string]interface{}, 0, len(s.tags[resourceID]))
+	for k, v := range s.tags[resourceID] {
+		tags = append(tags, map[string]interface{}{"key": k, "value": v})
+	}
+	return http.StatusOK, map[string]interface{}{"tags": tags}
+}
+
+func (s *Server) updateTags(resourceID string, body map[string]interface{}) (int, interface{}) {
+	tags, ok := s.tags[resourceID]
+	if !ok {
+		tags = make(map[string]string)
+		s.tags[resourceID] = tags
+	}
+
+	action, _ := body["action"].(string)
+	rawTags, _ := body["tags"].([]interface{})
+	for _, raw := range rawTags {
+		tag, _ := raw.(map[string]interface{})
+		key, _ := tag["key"].(string)
+		value, _ := tag["value"].(string)
+		switch action {
+		case "create":
+			tags[key] = value
+		case "delete":
+			delete(tags, key)
+		default:
+			return badRequest("invalid tag action %q", action)
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+// queryFilters returns the query parameters which filter the resources, the pagination parameters are excluded.
+func queryFilters(query url.Values) map[string]string {
+	filters := make(map[string]string)
+	for k, v := range query {
+		switch k {
+		case "marker", "limit", "offset", "page_reverse", "fields":
+			continue
+		}
+		if len(v) > 0 && v[0] != "" {
+			filters[k] = v[0]
+		}
+	}
+	return filters
+}
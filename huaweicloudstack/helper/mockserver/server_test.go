@@ -0,0 +1,122 @@
+package mockserver
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/zones"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/cloudservers"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/vpcs"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func newServiceClient(s *Server, version string, withProjectID bool) *golangsdk.ServiceClient {
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: ProjectID},
+		Endpoint:       s.URL + "/",
+		ResourceBase:   s.URL + "/" + version + "/",
+	}
+	if withProjectID {
+		client.ResourceBase += ProjectID + "/"
+	}
+	return client
+}
+
+func TestVpcLifecycle(t *testing.T) {
+	s := Start()
+	defer s.Reset()
+	client := newServiceClient(s, "v1", false)
+
+	vpc, err := vpcs.Create(client, vpcs.CreateOpts{Name: "vpc-test", CIDR: "192.168.0.0/16"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "CREATING", vpc.Status)
+
+	// the status is changed after the configured queries
+	vpc, err = vpcs.Get(client, vpc.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "OK", vpc.Status)
+
+	vpc, err = vpcs.Update(client, vpc.ID, vpcs.UpdateOpts{Name: "vpc-update"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "vpc-update", vpc.Name)
+	th.AssertEquals(t, "192.168.0.0/16", vpc.CIDR)
+
+	tagClient := newServiceClient(s, "v2.0", true)
+	th.AssertNoErr(t, tags.Create(tagClient, "vpcs", vpc.ID, []tags.ResourceTag{{Key: "foo", Value: "bar"}}).ExtractErr())
+	resourceTags, err := tags.Get(tagClient, "vpcs", vpc.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []tags.ResourceTag{{Key: "foo", Value: "bar"}}, resourceTags.Tags)
+
+	th.AssertNoErr(t, vpcs.Delete(client, vpc.ID).ExtractErr())
+	_, err = vpcs.Get(client, vpc.ID).Extract()
+	if _, ok := err.(golangsdk.ErrDefault404); !ok {
+		t.Fatalf("expected a 404 error after deleting the VPC, got %v", err)
+	}
+}
+
+func TestServerJobs(t *testing.T) {
+	s := Start()
+	defer s.Reset()
+	client := newServiceClient(s, "v1", true)
+
+	createOpts := cloudservers.CreateOpts{
+		Name:      "ecs-test",
+		ImageRef:  "image-id",
+		FlavorRef: "c6.large.2",
+		VpcId:     "vpc-id",
+		Nics:      []cloudservers.Nic{{SubnetId: "subnet-id"}},
+		RootVolume: cloudservers.RootVolume{
+			VolumeType: "SSD",
+		},
+	}
+	job, err := cloudservers.Create(client, createOpts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+
+	status, err := cloudservers.GetJobEntity(client, job.JobID, "server_id")
+	th.AssertEquals(t, nil, status)
+	th.AssertEquals(t, true, err != nil)
+
+	// the job succeeds at the second query
+	serverID, err := cloudservers.GetJobEntity(client, job.JobID, "server_id")
+	th.AssertNoErr(t, err)
+
+	server, err := cloudservers.Get(client, serverID.(string)).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ACTIVE", server.Status)
+	th.AssertEquals(t, "c6.large.2", server.Flavor.ID)
+	th.AssertEquals(t, 1, len(server.Addresses["vpc-id"]))
+
+	// the job succeeds at the first query without the pending polls
+	s.PendingPolls = 0
+	defer func() { s.PendingPolls = defaultPendingPolls }()
+	deleteOpts := cloudservers.DeleteOpts{Servers: []cloudservers.Server{{Id: server.ID}}}
+	job, err = cloudservers.Delete(client, deleteOpts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	_, err = cloudservers.GetJobEntity(client, job.JobID, "server_id")
+	th.AssertNoErr(t, err)
+
+	_, err = cloudservers.Get(client, server.ID).Extract()
+	if _, ok := err.(golangsdk.ErrDefault404); !ok {
+		t.Fatalf("expected a 404 error after deleting the server, got %v", err)
+	}
+}
+
+func TestDnsZones(t *testing.T) {
+	s := Start()
+	defer s.Reset()
+	client := newServiceClient(s, "v2", false)
+
+	zone, err := zones.Create(client, zones.CreateOpts{Name: "example.com.", Description: "test"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "PENDING_CREATE", zone.Status)
+
+	allPages, err := zones.List(client, zones.ListOpts{Name: "example.com."}).AllPages()
+	th.AssertNoErr(t, err)
+	allZones, err := zones.ExtractZones(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allZones))
+
+	_, err = zones.Delete(client, zone.ID).Extract()
+	th.AssertNoErr(t, err)
+}
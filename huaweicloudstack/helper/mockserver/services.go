@@ -0,0 +1,400 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// registerIdentity registers the IAM APIs used to authenticate by AK/SK, which return the same project and domain.
+func (s *Server) registerIdentity() {
+	listProjects := func(r *http.Request, _ map[string]string, _ map[string]interface{}) (int, interface{}) {
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			name = "mock-project"
+		}
+		return http.StatusOK, map[string]interface{}{
+			"projects": []map[string]interface{}{
+				{"id": ProjectID, "name": name, "domain_id": DomainID, "enabled": true},
+			},
+			"links": map[string]interface{}{},
+		}
+	}
+	s.handle("GET", "/v3/projects", listProjects)
+	s.handle("GET", "/v3/auth/projects", listProjects)
+
+	s.handle("GET", "/v3/auth/domains", func(_ *http.Request, _ map[string]string, _ map[string]interface{}) (int, interface{}) {
+		return http.StatusOK, map[string]interface{}{
+			"domains": []map[string]interface{}{
+				{"id": DomainID, "name": DomainName, "enabled": true},
+			},
+			"links": map[string]interface{}{},
+		}
+	})
+	s.handle("GET", "/v3/users", func(r *http.Request, _ map[string]string, _ map[string]interface{}) (int, interface{}) {
+		return http.StatusOK, map[string]interface{}{
+			"users": []map[string]interface{}{
+				{"id": DomainID, "name": r.URL.Query().Get("name"), "domain_id": DomainID, "enabled": true},
+			},
+			"links": map[string]interface{}{},
+		}
+	})
+}
+
+// registerVPC registers the APIs of the VPCs, subnets, security groups and ports.
+func (s *Server) registerVPC() {
+	vpcs := s.newCollection("vpcs", "vpc", "vpcs")
+	vpcs.status = &transition{field: "status", pending: "CREATING", target: "OK"}
+	vpcs.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description":           "",
+			"routes":                []interface{}{},
+			"extend_cidrs":          []interface{}{},
+			"enterprise_project_id": "0",
+		})
+	}
+	s.registerResources("/v1/vpcs", vpcs, defaultCodes, false)
+	s.handle("GET", "/v3/vpc/vpcs/{id}", s.getHandler(vpcs))
+	s.handle("PUT", "/v3/vpc/vpcs/{id}/add-extend-cidr", s.extendCidrHandler(vpcs, true))
+	s.handle("PUT", "/v3/vpc/vpcs/{id}/remove-extend-cidr", s.extendCidrHandler(vpcs, false))
+
+	subnets := s.newCollection("subnets", "subnet", "subnets")
+	subnets.status = &transition{field: "status", pending: "UNKNOWN", target: "ACTIVE"}
+	subnets.prepare = func(id string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description":        "",
+			"dhcp_enable":        true,
+			"dnsList":            []interface{}{},
+			"neutron_network_id": id,
+			"neutron_subnet_id":  id,
+		})
+	}
+	s.registerResources("/v1/subnets", subnets, defaultCodes, false)
+	s.handle("PUT", "/v1/vpcs/{vpc_id}/subnets/{id}", s.updateHandler(subnets))
+	s.handle("DELETE", "/v1/vpcs/{vpc_id}/subnets/{id}", s.deleteHandler(subnets, http.StatusNoContent))
+
+	// the v1, v2 and v3 APIs of the security groups share the same resources
+	secgroups := s.newCollection("security_groups", "security_group", "security_groups")
+	secgroups.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description":           "",
+			"security_group_rules":  []interface{}{},
+			"enterprise_project_id": "0",
+		})
+	}
+	s.registerResources("/v1/security-groups", secgroups, defaultCodes, false)
+	s.registerResources("/v3/vpc/security-groups", secgroups, defaultCodes, false)
+	s.handle("PUT", "/v2.0/security-groups/{id}", s.updateHandler(secgroups))
+
+	ports := s.newCollection("ports", "port", "ports")
+	ports.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"status":                "ACTIVE",
+			"fixed_ips":             []interface{}{},
+			"allowed_address_pairs": []interface{}{},
+		})
+	}
+	s.registerResources("/v1/ports", ports, defaultCodes, false)
+	s.registerResources("/v2.0/ports", ports, resourceCodes{create: http.StatusCreated, delete: http.StatusNoContent},
+		false)
+}
+
+// extendCidrHandler adds or removes the secondary CIDRs of the VPC.
+func (s *Server) extendCidrHandler(vpcs *collection, add bool) handlerFunc {
+	return func(_ *http.Request, params map[string]string, body map[string]interface{}) (int, interface{}) {
+		attrs, ok := vpcs.get(params["id"])
+		if !ok {
+			return notFound("the VPC %s does not exist", params["id"])
+		}
+
+		current := make(map[interface{}]bool)
+		var cidrs []interface{}
+		if existing, ok := attrs["extend_cidrs"].([]interface{}); ok {
+			for _, v := range existing {
+				current[v] = true
+				cidrs = append(cidrs, v)
+			}
+		}
+
+		changed, _ := vpcs.unwrap(body)["extend_cidrs"].([]interface{})
+		for _, v := range changed {
+			if add && !current[v] {
+				cidrs = append(cidrs, v)
+			}
+			current[v] = add
+		}
+
+		result := make([]interface{}, 0, len(cidrs))
+		for _, v := range cidrs {
+			if current[v] {
+				result = append(result, v)
+			}
+		}
+		attrs["extend_cidrs"] = result
+		return http.StatusOK, vpcs.wrap(attrs)
+	}
+}
+
+// job is an asynchronous task of the ECS, it succeeds after the configured queries.
+type job struct {
+	attrs        map[string]interface{}
+	pendingPolls int
+	// complete is called once when the job succeeds.
+	complete func()
+}
+
+// newJob stores a job of the server and returns its ID.
+func (s *Server) newJob(jobType, serverID string, complete func()) string {
+	id := s.newID()
+	s.jobs[id] = &job{
+		attrs: map[string]interface{}{
+			"job_id":     id,
+			"job_type":   jobType,
+			"begin_time": timestamp(),
+			"entities": map[string]interface{}{
+				"sub_jobs_total": 1,
+				"sub_jobs": []map[string]interface{}{
+					{
+						"job_id":   id,
+						"job_type": jobType,
+						"status":   "SUCCESS",
+						"entities": map[string]interface{}{"server_id": serverID},
+					},
+				},
+			},
+		},
+		pendingPolls: s.PendingPolls,
+		complete:     complete,
+	}
+	return id
+}
+
+func (s *Server) getJob(_ *http.Request, params map[string]string, _ map[string]interface{}) (int, interface{}) {
+	j, ok := s.jobs[params["id"]]
+	if !ok {
+		return notFound("the job %s does not exist", params["id"])
+	}
+
+	if j.pendingPolls > 0 {
+		j.pendingPolls--
+		j.attrs["status"] = "RUNNING"
+		return http.StatusOK, j.attrs
+	}
+
+	if j.complete != nil {
+		j.complete()
+		j.complete = nil
+	}
+	j.attrs["status"] = "SUCCESS"
+	j.attrs["end_time"] = timestamp()
+	return http.StatusOK, j.attrs
+}
+
+// registerECS registers the APIs of the cloud servers, which are created and deleted by the jobs.
+func (s *Server) registerECS() {
+	servers := s.newCollection("cloudservers", "server", "servers")
+	ports := s.collections["ports"]
+
+	s.handle("GET", "/v1/jobs/{id}", s.getJob)
+	s.handle("GET", "/v1/cloudservers/detail", s.listHandler(servers))
+	s.handle("GET", "/v1/cloudservers/{id}", s.getHandler(servers))
+	s.handle("PUT", "/v1/cloudservers/{id}", s.updateHandler(servers))
+
+	s.handle("POST", "/v1/cloudservers", func(_ *http.Request, _ map[string]string, body map[string]interface{}) (int, interface{}) {
+		request := servers.unwrap(body)
+		if request == nil {
+			return badRequest("the request body must contain %q", servers.key)
+		}
+
+		// the attributes of the server are different from the request, so they are built here
+		attrs := s.create(servers, nil, nil)
+		id := attrs["id"].(string)
+		attrs["name"] = request["name"]
+		attrs["description"] = request["description"]
+		attrs["status"] = "BUILD"
+		attrs["key_name"] = request["key_name"]
+		attrs["OS-EXT-AZ:availability_zone"] = request["availability_zone"]
+		attrs["flavor"] = map[string]interface{}{"id": request["flavorRef"], "name": request["flavorRef"]}
+		attrs["image"] = map[string]interface{}{"id": request["imageRef"]}
+		attrs["metadata"] = stringMap(request["metadata"])
+		attrs["tags"] = []interface{}{}
+		attrs["os-extended-volumes:volumes_attached"] = []interface{}{}
+
+		securityGroups := make([]map[string]interface{}, 0)
+		if groups, ok := request["security_groups"].([]interface{}); ok {
+			for _, v := range groups {
+				group, _ := v.(map[string]interface{})
+				securityGroups = append(securityGroups, map[string]interface{}{"id": group["id"], "name": group["id"]})
+			}
+		}
+		attrs["security_groups"] = securityGroups
+
+		// a port is created for each NIC, its network ID is the ID of the subnet
+		var addresses []map[string]interface{}
+		if nics, ok := request["nics"].([]interface{}); ok {
+			for i, v := range nics {
+				nic, _ := v.(map[string]interface{})
+				ip, _ := nic["ip_address"].(string)
+				if ip == "" {
+					ip = fmt.Sprintf("192.168.0.%d", 10+i)
+				}
+				mac := fmt.Sprintf("fa:16:3e:00:00:%02x", i)
+				port := s.create(ports, nil, map[string]interface{}{"port": map[string]interface{}{
+					"network_id":  nic["subnet_id"],
+					"device_id":   id,
+					"mac_address": mac,
+					"fixed_ips": []interface{}{
+						map[string]interface{}{"subnet_id": nic["subnet_id"], "ip_address": ip},
+					},
+				}})
+				addresses = append(addresses, map[string]interface{}{
+					"version":                 "4",
+					"addr":                    ip,
+					"OS-EXT-IPS-MAC:mac_addr": mac,
+					"OS-EXT-IPS:port_id":      port["id"],
+					"OS-EXT-IPS:type":         "fixed",
+				})
+			}
+		}
+		attrs["addresses"] = map[string]interface{}{fmt.Sprint(request["vpcid"]): addresses}
+
+		jobID := s.newJob("createServer", id, func() {
+			attrs["status"] = "ACTIVE"
+		})
+		return http.StatusOK, map[string]interface{}{
+			"job_id":    jobID,
+			"serverIds": []string{id},
+		}
+	})
+
+	s.handle("POST", "/v1/cloudservers/delete", func(_ *http.Request, _ map[string]string, body map[string]interface{}) (int, interface{}) {
+		var ids []string
+		if list, ok := body["servers"].([]interface{}); ok {
+			for _, v := range list {
+				server, _ := v.(map[string]interface{})
+				id, _ := server["id"].(string)
+				if _, ok := servers.items[id]; !ok {
+					return notFound("the server %s does not exist", id)
+				}
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return badRequest("the servers to be deleted must be specified")
+		}
+
+		jobID := s.newJob("deleteServer", ids[0], func() {
+			for _, id := range ids {
+				servers.delete(id)
+				delete(s.tags, id)
+				for _, port := range ports.list(map[string]string{"device_id": id}, "", 0) {
+					ports.delete(port["id"].(string))
+				}
+			}
+		})
+		return http.StatusOK, map[string]interface{}{"job_id": jobID}
+	})
+}
+
+// registerEVS registers the APIs of the volumes.
+func (s *Server) registerEVS() {
+	volumes := s.newCollection("volumes", "volume", "volumes")
+	volumes.status = &transition{field: "status", pending: "creating", target: "available"}
+	volumes.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description": "",
+			"metadata":    map[string]interface{}{},
+			"attachments": []interface{}{},
+			"multiattach": false,
+		})
+	}
+	s.registerResources("/v2/volumes", volumes, resourceCodes{create: http.StatusAccepted, delete: http.StatusAccepted},
+		true)
+	s.handle("GET", "/v2/cloudvolumes/{id}", s.getHandler(volumes))
+	s.handle("GET", "/v2.1/cloudvolumes/{id}", s.getHandler(volumes))
+	s.handle("GET", "/v2/cloudvolumes/detail", s.listHandler(volumes))
+}
+
+// registerELB registers the APIs of the dedicated load balancers.
+func (s *Server) registerELB() {
+	loadbalancers := s.newCollection("loadbalancers", "loadbalancer", "loadbalancers")
+	loadbalancers.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description":         "",
+			"provisioning_status": "ACTIVE",
+			"operating_status":    "ONLINE",
+			"listeners":           []interface{}{},
+			"pools":               []interface{}{},
+			"eips":                []interface{}{},
+			"publicips":           []interface{}{},
+		})
+	}
+	s.registerResources("/v3/elb/loadbalancers", loadbalancers,
+		resourceCodes{create: http.StatusCreated, delete: http.StatusNoContent}, false)
+}
+
+// registerNAT registers the APIs of the public NAT gateways.
+func (s *Server) registerNAT() {
+	gateways := s.newCollection("nat_gateways", "nat_gateway", "nat_gateways")
+	gateways.status = &transition{field: "status", pending: "PENDING_CREATE", target: "ACTIVE"}
+	gateways.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description":           "",
+			"admin_state_up":        true,
+			"enterprise_project_id": "0",
+		})
+	}
+	s.registerResources("/v2.0/nat_gateways", gateways,
+		resourceCodes{create: http.StatusCreated, delete: http.StatusNoContent}, false)
+}
+
+// registerDNS registers the APIs of the zones and recordsets, whose bodies are not wrapped by a key.
+func (s *Server) registerDNS() {
+	zones := s.newCollection("zones", "", "zones")
+	zones.status = &transition{field: "status", pending: "PENDING_CREATE", target: "ACTIVE"}
+	zones.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description": "",
+			"zone_type":   "public",
+			"ttl":         300,
+			"routers":     []interface{}{},
+		})
+	}
+	s.registerResources("/v2/zones", zones, resourceCodes{create: http.StatusAccepted, delete: http.StatusAccepted},
+		false)
+	s.handle("PATCH", "/v2/zones/{id}", s.updateHandler(zones))
+
+	recordsets := s.newCollection("recordsets", "", "recordsets")
+	recordsets.status = &transition{field: "status", pending: "PENDING_CREATE", target: "ACTIVE"}
+	recordsets.prepare = func(_ string, attrs map[string]interface{}) {
+		setDefaults(attrs, map[string]interface{}{
+			"description": "",
+			"ttl":         300,
+			"records":     []interface{}{},
+		})
+	}
+	s.registerResources("/v2/zones/{zone_id}/recordsets", recordsets,
+		resourceCodes{create: http.StatusAccepted, delete: http.StatusAccepted}, false)
+	s.handle("GET", "/v2/recordsets", s.listHandler(recordsets))
+}
+
+// setDefaults sets the attributes which are not specified in the request.
+func setDefaults(attrs, defaults map[string]interface{}) {
+	for k, v := range defaults {
+		if _, ok := attrs[k]; !ok {
+			attrs[k] = v
+		}
+	}
+}
+
+// stringMap returns the string values of the JSON object, such as the metadata.
+func stringMap(raw interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if m, ok := raw.(map[string]interface{}); ok {
+		for k, v := range m {
+			if value, ok := v.(string); ok {
+				result[k] = value
+			}
+		}
+	}
+	return result
+}
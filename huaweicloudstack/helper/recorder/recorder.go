@@ -0,0 +1,309 @@
+// Package recorder records the HTTP interactions of the acceptance tests to the cassette files and replays them,
+// so the tests can run without a live HuaweiCloudStack.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of the recorder.
+type Mode string
+
+const (
+	// ModeDisabled sends the requests to the real endpoints without recording.
+	ModeDisabled Mode = ""
+	// ModeRecord sends the requests to the real endpoints and records the interactions to the cassette.
+	ModeRecord Mode = "record"
+	// ModeReplay serves the requests by the interactions in the cassette, no request is sent.
+	ModeReplay Mode = "replay"
+)
+
+// sensitiveHeaders are the headers whose values are never written to the cassettes.
+var sensitiveHeaders = []string{"authorization", "token", "cookie"}
+
+// Cassette is the document of the recorded interactions.
+type Cassette struct {
+	// Variables are the settings of the provider when the interactions are recorded, such as the region name,
+	// they are restored in replay mode because the requests contain them. The credentials are never saved.
+	Variables    map[string]string `json:"variables,omitempty"`
+	Interactions []Interaction     `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of the recorded request which is used to match the replayed requests.
+type Request struct {
+	Method string `json:"method"`
+	// Path is the path of the URL followed by the sorted query parameters, the host is not recorded.
+	Path string `json:"path"`
+	// Body is the canonical JSON of the request body, or the raw body if it is not a JSON document.
+	Body string `json:"body,omitempty"`
+}
+
+// Response is the recorded response.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+func (r Request) key() string {
+	return fmt.Sprintf("%s %s %s", r.Method, r.Path, r.Body)
+}
+
+// Recorder records or replays the HTTP interactions, it is shared by all transports wrapped by it.
+type Recorder struct {
+	Mode Mode
+	Path string
+
+	// sanitize masks the sensitive fields of the JSON bodies before they are recorded or matched.
+	sanitize func(map[string]interface{})
+
+	lock     sync.Mutex
+	cassette Cassette
+	replayed map[string]int
+}
+
+// New returns a recorder of the cassette file. The cassette is loaded in replay mode.
+func New(mode Mode, path string, sanitize func(map[string]interface{})) (*Recorder, error) {
+	if mode != ModeDisabled && mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("invalid recorder mode %q, valid values are %q and %q", mode, ModeRecord, ModeReplay)
+	}
+
+	r := &Recorder{
+		Mode:     mode,
+		Path:     path,
+		sanitize: sanitize,
+		replayed: make(map[string]int),
+	}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the cassette: %s", err)
+	}
+	if err := json.Unmarshal(contents, &r.cassette); err != nil {
+		return nil, fmt.Errorf("error parsing the cassette %s: %s", path, err)
+	}
+	return r, nil
+}
+
+// Save writes the recorded interactions to the cassette file, it does nothing if the mode is not record.
+func (r *Recorder) Save() error {
+	if r.Mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, contents, 0644)
+}
+
+// SetVariable saves the setting of the provider to the cassette in record mode.
+func (r *Recorder) SetVariable(name, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cassette.Variables == nil {
+		r.cassette.Variables = make(map[string]string)
+	}
+	r.cassette.Variables[name] = value
+}
+
+// Variable returns the setting of the provider saved in the cassette, and whether it is saved.
+func (r *Recorder) Variable(name string) (string, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	value, ok := r.cassette.Variables[name]
+	return value, ok
+}
+
+// Wrap returns a transport which records the interactions sent by rt, or replays them without rt.
+func (r *Recorder) Wrap(rt http.RoundTripper) http.RoundTripper {
+	if r == nil || r.Mode == ModeDisabled {
+		return rt
+	}
+	return &transport{recorder: r, rt: rt}
+}
+
+type transport struct {
+	recorder *Recorder
+	rt       http.RoundTripper
+}
+
+// RoundTrip records or replays the interaction of the request.
+func (t *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	r := t.recorder
+	body, err := readBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method: request.Method,
+		Path:   request.URL.EscapedPath(),
+		Body:   r.canonicalBody(body),
+	}
+	if query := request.URL.Query(); len(query) > 0 {
+		// Encode sorts the parameters by key
+		recorded.Path += "?" + query.Encode()
+	}
+
+	if r.Mode == ModeReplay {
+		return r.replay(request, recorded)
+	}
+
+	response, err := t.rt.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Headers:    redactHeaders(response.Header),
+			Body:       r.sanitizeBody(responseBody),
+		},
+	})
+	return response, nil
+}
+
+// replay returns the response of the recorded interactions which match the request in order,
+// the last one is returned repeatedly once they are all replayed, such as the polling of the status.
+func (r *Recorder) replay(request *http.Request, recorded Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := recorded.key()
+	var matched []Interaction
+	for _, v := range r.cassette.Interactions {
+		if v.Request.key() == key {
+			matched = append(matched, v)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no interaction in the cassette %s matches the request: %s %s", r.Path,
+			recorded.Method, recorded.Path)
+	}
+
+	index := r.replayed[key]
+	if index < len(matched)-1 {
+		r.replayed[key] = index + 1
+	} else {
+		index = len(matched) - 1
+	}
+
+	recordedResponse := matched[index].Response
+	header := make(http.Header, len(recordedResponse.Headers))
+	for k, v := range recordedResponse.Headers {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResponse.StatusCode, http.StatusText(recordedResponse.StatusCode)),
+		StatusCode:    recordedResponse.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recordedResponse.Body)),
+		ContentLength: int64(len(recordedResponse.Body)),
+		Request:       request,
+	}, nil
+}
+
+// canonicalBody returns the sanitized JSON body with the sorted keys, so the requests are matched regardless of
+// the order of the fields. The body which is not a JSON object is returned as it is.
+func (r *Recorder) canonicalBody(body []byte) string {
+	var data map[string]interface{}
+	if len(body) == 0 || json.Unmarshal(body, &data) != nil {
+		return string(body)
+	}
+
+	if r.sanitize != nil {
+		r.sanitize(data)
+	}
+	canonical, err := json.Marshal(data)
+	if err != nil {
+		return string(body)
+	}
+	return string(canonical)
+}
+
+// sanitizeBody masks the sensitive fields of the JSON response body and keeps the other bodies as they are.
+func (r *Recorder) sanitizeBody(body []byte) string {
+	var data map[string]interface{}
+	if r.sanitize == nil || len(body) == 0 || json.Unmarshal(body, &data) != nil {
+		return string(body)
+	}
+
+	r.sanitize(data)
+	sanitized, err := json.Marshal(data)
+	if err != nil {
+		return string(body)
+	}
+	return string(sanitized)
+}
+
+// redactHeaders returns the headers without the length, the values of the sensitive headers are masked.
+func redactHeaders(headers http.Header) map[string][]string {
+	result := make(map[string][]string, len(headers))
+	for name, values := range headers {
+		if name == "Content-Length" {
+			continue
+		}
+
+		lowerName := strings.ToLower(name)
+		for _, word := range sensitiveHeaders {
+			if strings.Contains(lowerName, word) {
+				values = []string{"***"}
+				break
+			}
+		}
+		result[name] = values
+	}
+	return result
+}
+
+// readBody reads the whole body and replaces it with a new reader of the contents.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	defer (*body).Close()
+	contents, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(contents))
+	return contents, nil
+}
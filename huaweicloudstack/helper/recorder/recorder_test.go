@@ -0,0 +1,137 @@
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func maskPassword(data map[string]interface{}) {
+	if _, ok := data["password"]; ok {
+		data["password"] = "***"
+	}
+	for _, v := range data {
+		if v, ok := v.(map[string]interface{}); ok {
+			maskPassword(v)
+		}
+	}
+}
+
+func sendRequest(t *testing.T, client *http.Client, method, url, body string) (int, string) {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("X-Auth-Token", "secret-token")
+
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	contents, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, string(contents)
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	var status string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Subject-Token", "secret-token")
+		switch r.Method {
+		case "POST":
+			status = "CREATING"
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"vpc":{"id":"vpc-1","password":"Test@123"}}`))
+		case "GET":
+			w.Write([]byte(`{"vpc":{"id":"vpc-1","status":"` + status + `"}}`))
+			status = "OK"
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "recordings", "test.json")
+	r, err := New(ModeRecord, path, maskPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: r.Wrap(http.DefaultTransport)}
+	sendRequest(t, client, "POST", server.URL+"/v1/vpcs", `{"vpc":{"name":"test","cidr":"192.168.0.0/16"}}`)
+	sendRequest(t, client, "GET", server.URL+"/v1/vpcs/vpc-1?fields=id&limit=1", "")
+	sendRequest(t, client, "GET", server.URL+"/v1/vpcs/vpc-1?fields=id&limit=1", "")
+	if err := r.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = New(ModeReplay, path, maskPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range r.cassette.Interactions {
+		for name, values := range v.Response.Headers {
+			if name == "X-Subject-Token" && values[0] != "***" {
+				t.Fatalf("the sensitive header is recorded: %s", values[0])
+			}
+		}
+	}
+
+	// the requests are sent to another host and the fields of the body are in another order
+	client = &http.Client{Transport: r.Wrap(nil)}
+	code, body := sendRequest(t, client, "POST", "https://vpc.example.com/v1/vpcs",
+		`{"vpc":{"cidr":"192.168.0.0/16","name":"test"}}`)
+	if code != http.StatusCreated || body != `{"vpc":{"id":"vpc-1","password":"***"}}` {
+		t.Fatalf("unexpected replayed response: %d %s", code, body)
+	}
+
+	// the polling requests are replayed in order, and the last one is replayed repeatedly
+	expected := []string{"CREATING", "OK", "OK"}
+	for _, v := range expected {
+		_, body := sendRequest(t, client, "GET", "https://vpc.example.com/v1/vpcs/vpc-1?limit=1&fields=id", "")
+		if !strings.Contains(body, v) {
+			t.Fatalf("expected the status %s, got %s", v, body)
+		}
+	}
+
+	request, _ := http.NewRequest("DELETE", "https://vpc.example.com/v1/vpcs/vpc-1", nil)
+	if _, err := client.Do(request); err == nil {
+		t.Fatalf("expected an error for the request which is not recorded")
+	}
+}
+
+func TestRecorderVariables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.json")
+	r, err := New(ModeRecord, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetVariable("HCS_REGION_NAME", "cn-north-1")
+	if err := r.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = New(ModeReplay, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := r.Variable("HCS_REGION_NAME"); !ok || value != "cn-north-1" {
+		t.Fatalf("expected the recorded region cn-north-1, got %q", value)
+	}
+	if _, ok := r.Variable("HCS_PROJECT_ID"); ok {
+		t.Fatalf("expected no value of the variable which is not recorded")
+	}
+}
+
+func TestRecorderInvalidMode(t *testing.T) {
+	if _, err := New("playback", "test.json", nil); err == nil {
+		t.Fatalf("expected an error for the invalid mode")
+	}
+	if _, err := New(ModeReplay, filepath.Join(t.TempDir(), "missing.json"), nil); err == nil {
+		t.Fatalf("expected an error for the missing cassette")
+	}
+}
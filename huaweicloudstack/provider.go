@@ -7,27 +7,11 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/aom"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cfw"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dcs"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dew"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dws"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lts"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/obs"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/secmaster"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/servicestage"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/sfs"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ucs"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/waf"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/as"
@@ -57,6 +41,7 @@ import (
 	hcsMrs "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/mrs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/nat"
 	hcsObs "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/obs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
 	hcsRds "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/rds"
 	hcsRomaConnect "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/romaconnect"
 	hcsSecmaster "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/secmaster"
@@ -68,6 +53,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/vpc"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/vpcep"
 	hcsWaf "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/waf"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
 // Provider returns a schema.Provider for HuaweiCloudStack.
@@ -171,9 +157,9 @@ func Provider() *schema.Provider {
 			},
 
 			"assume_role": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions["assume_role"],
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"agency_name": {
@@ -188,6 +174,17 @@ func Provider() *schema.Provider {
 							Description: descriptions["assume_role_domain_name"],
 							DefaultFunc: schema.EnvDefaultFunc("HCS_ASSUME_ROLE_DOMAIN_NAME", nil),
 						},
+						"duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  descriptions["assume_role_duration"],
+							ValidateFunc: validation.IntBetween(900, 86400),
+						},
+						"project_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions["assume_role_project_name"],
+						},
 					},
 				},
 			},
@@ -314,6 +311,23 @@ func Provider() *schema.Provider {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 
+			"endpoint_discovery": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["endpoint_discovery"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_ENDPOINT_DISCOVERY", config.EndpointDiscoveryTemplate),
+				ValidateFunc: validation.StringInSlice([]string{
+					config.EndpointDiscoveryTemplate, config.EndpointDiscoveryCatalog,
+				}, false),
+			},
+
+			"endpoint_profile_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["endpoint_profile_file"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_ENDPOINT_PROFILE_FILE", ""),
+			},
+
 			"regional": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -334,6 +348,13 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HCS_PROFILE", ""),
 			},
 
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["credential_process"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_CREDENTIAL_PROCESS", ""),
+			},
+
 			"enterprise_project_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -348,12 +369,179 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HCS_MAX_RETRIES", 5),
 			},
 
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["retry"],
+				Elem: &schema.Resource{
+					Schema: buildRetrySchema(map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: descriptions["retry_service"],
+							Elem: &schema.Resource{
+								Schema: buildRetrySchema(map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions["service_name"],
+									},
+								}),
+							},
+						},
+					}),
+				},
+			},
+
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["rate_limit"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  descriptions["rate_limit_requests_per_second"],
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  descriptions["rate_limit_burst"],
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: descriptions["rate_limit_service"],
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions["service_name"],
+									},
+									"requests_per_second": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										Description:  descriptions["rate_limit_requests_per_second"],
+										ValidateFunc: validation.FloatAtLeast(0.01),
+									},
+									"burst": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Description:  descriptions["rate_limit_burst"],
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: descriptions["default_tags_tags"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["ignore_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["ignore_tags_keys"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["ignore_tags_key_prefixes"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["tracing"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions["tracing_endpoint"],
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Sensitive:   true,
+							Description: descriptions["tracing_headers"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     config.DefaultTracingServiceName,
+							Description: descriptions["tracing_service_name"],
+						},
+					},
+				},
+			},
+
+			"audit_log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["audit_log_file"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_AUDIT_LOG_FILE", ""),
+			},
+
+			"audit_log_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["audit_log_level"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_AUDIT_LOG_LEVEL", config.AuditLogLevelMutating),
+				ValidateFunc: validation.StringInSlice([]string{
+					config.AuditLogLevelMutating, config.AuditLogLevelAll,
+				}, false),
+			},
+
 			"enable_force_new": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: descriptions["enable_force_new"],
 				DefaultFunc: schema.EnvDefaultFunc("HCS_ENABLE_FORCE_NEW", false),
 			},
+
+			"quota_precheck": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["quota_precheck"],
+				DefaultFunc: schema.EnvDefaultFunc("HCS_QUOTA_PRECHECK", false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -362,35 +550,17 @@ func Provider() *schema.Provider {
 
 			"hcs_bms_flavors": bms.DataSourceBmsFlavors(),
 
-			"hcs_cce_cluster":             cce.DataSourceCCEClusterV3(),
-			"hcs_cce_clusters":            cce.DataSourceCCEClusters(),
-			"hcs_cce_addon_template":      cce.DataSourceAddonTemplate(),
-			"hcs_cce_node_pool":           cce.DataSourceCCENodePoolV3(),
-			"hcs_cce_node":                cce.DataSourceNode(),
-			"hcs_cce_nodes":               cce.DataSourceNodes(),
 			"hcs_cce_cluster_certificate": hcsCce.DataSourceCCEClusterCertificate(),
 
-			"hcs_cfw_firewalls":                 cfw.DataSourceFirewalls(),
 			"hcs_cfw_protection_rule_hit_count": hcsCfw.DataSourceCfwProtectionRuleHitCount(),
 
-			"hcs_dcs_flavors":         dcs.DataSourceDcsFlavorsV2(),
-			"hcs_dcs_instances":       dcs.DataSourceDcsInstance(),
-			"hcs_dcs_templates":       dcs.DataSourceTemplates(),
-			"hcs_dcs_template_detail": dcs.DataSourceTemplateDetail(),
-
 			"hcs_csms_secret_version": hcsCsms.DataSourceDewCsmsSecret(),
 
-			"hcs_kms_key":      dew.DataSourceKmsKey(),
-			"hcs_kms_data_key": dew.DataSourceKmsDataKeyV1(),
-
-			"hcs_dms_kafka_instances": dms.DataSourceDmsKafkaInstances(),
-			"hcs_dms_kafka_flavors":   dms.DataSourceKafkaFlavors(),
-			"hcs_dms_maintainwindow":  dms.DataSourceDmsMaintainWindow(),
+			"hcs_dns_recordsets": dns.DataSourceDNSRecordsets(),
+			"hcs_dns_zones":      dns.DataSourceDNSZones(),
 
 			"hcs_drs_availability_zones": hcsDrs.DataSourceAvailabilityZones(),
 
-			"hcs_dws_flavors": dws.DataSourceDwsFlavors(),
-
 			"hcs_availability_zones":       ecs.DataSourceAvailabilityZones(),
 			"hcs_ecs_compute_flavors":      ecs.DataSourceEcsFlavors(),
 			"hcs_ecs_compute_instance":     ecs.DataSourceComputeInstance(),
@@ -420,20 +590,18 @@ func Provider() *schema.Provider {
 			"hcs_lts_groups":  hcsLts.DataSourceLogGroups(),
 			"hcs_lts_streams": hcsLts.DataSourceLogStreams(),
 
-			"hcs_mrs_cluster":  hcsMrs.DataSourceMrsCluster(),
-			"hcs_mrs_clusters": mrs.DataSourceMrsClusters(),
-			"hcs_mrs_versions": mrs.DataSourceMrsVersions(),
-
-			"hcs_nat_gateway": nat.DataSourcePublicGateway(),
-
-			"hcs_obs_buckets":       obs.DataSourceObsBuckets(),
-			"hcs_obs_bucket_object": obs.DataSourceObsBucketObject(),
+			"hcs_mrs_cluster": hcsMrs.DataSourceMrsCluster(),
 
-			"hcs_rds_pg_plugins": rds.DataSourcePgPlugins(),
+			"hcs_nat_gateway":             nat.DataSourcePublicGateway(),
+			"hcs_nat_gateways":            nat.DataSourcePublicGateways(),
+			"hcs_nat_snat_rules":          nat.DataSourcePublicSnatRules(),
+			"hcs_nat_dnat_rules":          nat.DataSourcePublicDnatRules(),
+			"hcs_nat_private_gateways":    nat.DataSourcePrivateGateways(),
+			"hcs_nat_private_transit_ips": nat.DataSourcePrivateTransitIps(),
+			"hcs_nat_private_snat_rules":  nat.DataSourcePrivateSnatRules(),
+			"hcs_nat_private_dnat_rules":  nat.DataSourcePrivateDnatRules(),
 
-			"hcs_sfs_file_system": sfs.DataSourceSFSFileSystemV2(),
-
-			"hcs_sfs_turbos": sfs.DataSourceTurbos(),
+			"hcs_quotas": quota.DataSourceQuotas(),
 
 			"hcs_smn_topics": smn.DataSourceTopics(),
 
@@ -459,33 +627,14 @@ func Provider() *schema.Provider {
 
 			"hcs_vpcep_public_services": vpcep.DataSourceVPCEPPublicServices(),
 
-			"hcs_waf_certificate":         waf.DataSourceWafCertificateV1(),
-			"hcs_waf_dedicated_instances": waf.DataSourceWafDedicatedInstancesV1(),
-			"hcs_waf_policies":            waf.DataSourceWafPoliciesV1(),
-			"hcs_waf_reference_tables":    waf.DataSourceWafReferenceTablesV1(),
-
 			"hcs_direct_connect":    vpc.DataSourceDirectConnect(),
 			"hcs_virtual_gateway":   vpc.DataSourceVirtualGateway(),
 			"hcs_virtual_interface": vpc.DataSourceVirtualInterface(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"hcs_aom_alarm_rule":             aom.ResourceAlarmRule(),
-			"hcs_aom_service_discovery_rule": aom.ResourceServiceDiscoveryRule(),
-
-			"hcs_cce_addon":       cce.ResourceAddon(),
-			"hcs_cce_cluster":     cce.ResourceCluster(),
-			"hcs_cce_namespace":   cce.ResourceCCENamespaceV1(),
-			"hcs_cce_node":        cce.ResourceNode(),
-			"hcs_cce_node_attach": cce.ResourceNodeAttach(),
-			"hcs_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
-			"hcs_cce_node_pool":   hcsCce.ResourceNodePool(),
-
-			"hcs_cfw_address_group":        cfw.ResourceAddressGroup(),
-			"hcs_cfw_black_white_list":     cfw.ResourceBlackWhiteList(),
-			"hcs_cfw_eip_protection":       cfw.ResourceEipProtection(),
-			"hcs_cfw_service_group_member": cfw.ResourceServiceGroupMember(),
-			"hcs_cfw_service_group":        cfw.ResourceServiceGroup(),
+			"hcs_cce_node_pool": hcsCce.ResourceNodePool(),
+
 			"hcs_cfw_address_group_member": hcsCfw.ResourceAddressGroupMember(),
 			"hcs_cfw_protection_rule":      hcsCfw.ResourceProtectionRule(),
 
@@ -498,7 +647,6 @@ func Provider() *schema.Provider {
 
 			"hcs_dcs_instance": hcsDcs.ResourceDcsInstance(),
 			"hcs_dcs_account":  hcsDcs.ResourceDcsAccount(),
-			"hcs_dcs_backup":   dcs.ResourceDcsBackup(),
 
 			"hcs_csms_secret": hcsCsms.ResourceCsmsSecret(),
 
@@ -507,30 +655,16 @@ func Provider() *schema.Provider {
 			"hcs_ddm_instance_read_strategy": hcsDdm.ResourceDdmInstanceReadStrategy(),
 			"hcs_ddm_schema":                 hcsDdm.ResourceDdmSchema(),
 
-			"hcs_kms_key":   dew.ResourceKmsKey(),
-			"hcs_kms_grant": dew.ResourceKmsGrant(),
-
-			"hcs_dms_kafka_instance":       hcsDms.ResourceDmsKafkaInstance(),
-			"hcs_dms_kafka_consumer_group": dms.ResourceDmsKafkaConsumerGroup(),
-			"hcs_dms_kafka_permissions":    dms.ResourceDmsKafkaPermissions(),
-			"hcs_dms_kafka_topic":          dms.ResourceDmsKafkaTopic(),
-			"hcs_dms_kafka_user":           dms.ResourceDmsKafkaUser(),
+			"hcs_dms_kafka_instance": hcsDms.ResourceDmsKafkaInstance(),
 
 			"hcs_dms_rocketmq_instance":       hcsDms.ResourceDmsRocketMQInstance(),
 			"hcs_dms_rocketmq_consumer_group": hcsDms.ResourceDmsRocketMQConsumerGroup(),
 			"hcs_dms_rocketmq_topic":          hcsDms.ResourceDmsRocketMQTopic(),
-			"hcs_dms_rocketmq_user":           dms.ResourceDmsRocketMQUser(),
 
+			"hcs_dns_ptrrecord": dns.ResourceDNSPtrRecord(),
 			"hcs_dns_recordset": dns.ResourceDNSRecordset(),
 			"hcs_dns_zone":      dns.ResourceDNSZone(),
 
-			"hcs_dws_cluster":            dws.ResourceDwsCluster(),
-			"hcs_dws_alarm_subscription": dws.ResourceDwsAlarmSubs(),
-			"hcs_dws_event_subscription": dws.ResourceDwsEventSubs(),
-			"hcs_dws_ext_data_source":    dws.ResourceDwsExtDataSource(),
-			"hcs_dws_snapshot":           dws.ResourceDwsSnapshot(),
-			"hcs_dws_snapshot_policy":    dws.ResourceDwsSnapshotPolicy(),
-
 			"hcs_ecs_compute_volume_attach":     ecs.ResourceComputeVolumeAttach(),
 			"hcs_ecs_compute_server_group":      ecs.ResourceComputeServerGroup(),
 			"hcs_ecs_compute_interface_attach":  ecs.ResourceComputeInterfaceAttach(),
@@ -571,71 +705,29 @@ func Provider() *schema.Provider {
 
 			"hcs_hss_host_group": hcsHss.ResourceHostGroup(),
 
-			"hcs_lts_group":                     hcsLts.ResourceLTSGroup(),
-			"hcs_lts_host_access":               lts.ResourceHostAccessConfig(),
-			"hcs_lts_host_group":                lts.ResourceHostGroup(),
-			"hcs_lts_search_criteria":           lts.ResourceSearchCriteria(),
-			"hcs_lts_stream":                    lts.ResourceLTSStream(),
-			"hcs_lts_structuring_configuration": lts.ResourceStructConfig(),
-			"hcs_lts_transfer":                  lts.ResourceLtsTransfer(),
+			"hcs_lts_group": hcsLts.ResourceLTSGroup(),
 
 			"hcs_mrs_cluster": hcsMrs.ResourceMRSClusterV2(),
-			"hcs_mrs_job":     mrs.ResourceMRSJobV2(),
-
-			"hcs_obs_bucket":            hcsObs.ResourceObsBucket(),
-			"hcs_obs_bucket_acl":        hcsObs.ResourceOBSBucketAcl(),
-			"hcs_obs_bucket_object":     obs.ResourceObsBucketObject(),
-			"hcs_obs_bucket_object_acl": obs.ResourceOBSBucketObjectAcl(),
-			"hcs_obs_bucket_policy":     obs.ResourceObsBucketPolicy(),
 
-			// rds common
-			"hcs_rds_instance":  rds.ResourceRdsInstance(),
-			"hcs_rds_sql_audit": rds.ResourceSQLAudit(),
+			"hcs_obs_bucket":     hcsObs.ResourceObsBucket(),
+			"hcs_obs_bucket_acl": hcsObs.ResourceOBSBucketAcl(),
 
 			// rds PostgreSQL
-			"hcs_rds_pg_account":            rds.ResourcePgAccount(),
-			"hcs_rds_pg_database":           rds.ResourcePgDatabase(),
-			"hcs_rds_pg_plugin":             rds.ResourceRdsPgPlugin(),
 			"hcs_rds_pg_database_privilege": hcsRds.ResourcePgDatabasePrivilege(),
 
-			// rds MySQL
-			"hcs_rds_mysql_account":            rds.ResourceMysqlAccount(),
-			"hcs_rds_mysql_database":           rds.ResourceMysqlDatabase(),
-			"hcs_rds_mysql_database_privilege": rds.ResourceMysqlDatabasePrivilege(),
-
 			"hcs_roma_connect_instance": hcsRomaConnect.ResourceRomaConnectInstance(),
 
-			"hcs_secmaster_alert":            hcsSecmaster.ResourceAlert(),
-			"hcs_secmaster_incident":         hcsSecmaster.ResourceIncident(),
-			"hcs_secmaster_indicator":        hcsSecmaster.ResourceIndicator(),
-			"hcs_secmaster_alert_rule":       secmaster.ResourceAlertRule(),
-			"hcs_secmaster_playbook":         secmaster.ResourcePlaybook(),
-			"hcs_secmaster_playbook_action":  secmaster.ResourcePlaybookAction(),
-			"hcs_secmaster_playbook_version": secmaster.ResourcePlaybookVersion(),
-			"hcs_secmaster_playbook_rule":    secmaster.ResourcePlaybookRule(),
+			"hcs_secmaster_alert":     hcsSecmaster.ResourceAlert(),
+			"hcs_secmaster_incident":  hcsSecmaster.ResourceIncident(),
+			"hcs_secmaster_indicator": hcsSecmaster.ResourceIndicator(),
 
-			"hcs_servicestage_application": servicestage.ResourceApplication(),
 			"hcs_servicestage_environment": hcsServicestage.ResourceEnvironment(),
 
 			"hcs_servicestagev3_component": hcsServicestage.ResourceV3Component(),
 
 			"hcs_sfs_access_rule": hcsSfs.ResourceSFSAccessRuleV2(),
-			"hcs_sfs_file_system": sfs.ResourceSFSFileSystemV2(),
-
-			"hcs_sfs_turbo":           hcsSfsturbo.ResourceSFSTurbo(),
-			"hcs_sfs_turbo_dir":       sfs.ResourceSfsTurboDir(),
-			"hcs_sfs_turbo_dir_quota": sfs.ResourceSfsTurboDirQuota(),
 
-			"hcs_swr_image_retention_policy":   swr.ResourceSwrImageRetentionPolicy(),
-			"hcs_swr_image_trigger":            swr.ResourceSwrImageTrigger(),
-			"hcs_swr_organization":             swr.ResourceSWROrganization(),
-			"hcs_swr_organization_permissions": swr.ResourceSWROrganizationPermissions(),
-			"hcs_swr_repository":               swr.ResourceSWRRepository(),
-			"hcs_swr_repository_sharing":       swr.ResourceSWRRepositorySharing(),
-
-			"hcs_ucs_cluster": ucs.ResourceCluster(),
-			"hcs_ucs_fleet":   ucs.ResourceFleet(),
-			"hcs_ucs_policy":  ucs.ResourcePolicy(),
+			"hcs_sfs_turbo": hcsSfsturbo.ResourceSFSTurbo(),
 
 			"hcs_vdc_agency":                vdc.ResourceVdcAgency(),
 			"hcs_vdc_group":                 vdc.ResourceVdcUserGroup(),
@@ -649,21 +741,7 @@ func Provider() *schema.Provider {
 			"hcs_vpcep_endpoint": vpcep.ResourceVPCEndpoint(),
 			"hcs_vpcep_service":  vpcep.ResourceVPCEndpointService(),
 
-			"hcs_waf_dedicated_instance":                  hcsWaf.ResourceWafDedicatedInstance(),
-			"hcs_waf_address_group":                       waf.ResourceWafAddressGroup(),
-			"hcs_waf_certificate":                         waf.ResourceWafCertificateV1(),
-			"hcs_waf_dedicated_domain":                    waf.ResourceWafDedicatedDomain(),
-			"hcs_waf_policy":                              waf.ResourceWafPolicyV1(),
-			"hcs_waf_reference_table":                     waf.ResourceWafReferenceTableV1(),
-			"hcs_waf_rule_blacklist":                      waf.ResourceWafRuleBlackListV1(),
-			"hcs_waf_rule_cc_protection":                  waf.ResourceRuleCCProtection(),
-			"hcs_waf_rule_data_masking":                   waf.ResourceWafRuleDataMaskingV1(),
-			"hcs_waf_rule_geolocation_access_control":     waf.ResourceRuleGeolocation(),
-			"hcs_waf_rule_known_attack_source":            waf.ResourceRuleKnownAttack(),
-			"hcs_waf_rule_global_protection_whitelist":    waf.ResourceRuleGlobalProtectionWhitelist(),
-			"hcs_waf_rule_information_leakage_prevention": waf.ResourceRuleLeakagePrevention(),
-			"hcs_waf_rule_precise_protection":             waf.ResourceRulePreciseProtection(),
-			"hcs_waf_rule_web_tamper_protection":          waf.ResourceWafRuleWebTamperProtectionV1(),
+			"hcs_waf_dedicated_instance": hcsWaf.ResourceWafDedicatedInstance(),
 
 			// Legacy
 			"hcs_as_bandwidth_policy": as.ResourceASBandWidthPolicy(),
@@ -682,9 +760,13 @@ func Provider() *schema.Provider {
 			"hcs_ims_image_share":          ims.ResourceImsImageShare(),
 			"hcs_ims_image_share_accepter": ims.ResourceImsImageShareAccepter(),
 
-			"hcs_nat_gateway":   nat.ResourcePublicGateway(),
-			"hcs_nat_snat_rule": nat.ResourcePublicSnatRule(),
-			"hcs_nat_dnat_rule": nat.ResourcePublicDnatRule(),
+			"hcs_nat_gateway":            nat.ResourcePublicGateway(),
+			"hcs_nat_snat_rule":          nat.ResourcePublicSnatRule(),
+			"hcs_nat_dnat_rule":          nat.ResourcePublicDnatRule(),
+			"hcs_nat_private_gateway":    nat.ResourcePrivateGateway(),
+			"hcs_nat_private_transit_ip": nat.ResourcePrivateTransitIp(),
+			"hcs_nat_private_snat_rule":  nat.ResourcePrivateSnatRule(),
+			"hcs_nat_private_dnat_rule":  nat.ResourcePrivateDnatRule(),
 
 			"hcs_smn_topic":            smn.ResourceTopic(),
 			"hcs_smn_subscription":     smn.ResourceSubscription(),
@@ -723,6 +805,29 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// manage the project-scoped resources in the project specified by project_id or project_name, the resources of
+	// terraform-provider-huaweicloud are registered after that, their service clients ignore the resource-level project
+	for _, r := range provider.DataSourcesMap {
+		config.ScopeResourceProject(true, r)
+	}
+	for _, r := range provider.ResourcesMap {
+		config.ScopeResourceProject(false, r)
+	}
+	for name, r := range upstreamDataSources() {
+		provider.DataSourcesMap[name] = r
+	}
+	for name, r := range upstreamResources() {
+		provider.ResourcesMap[name] = r
+	}
+
+	// record the spans of the Terraform operations if the tracing is enabled
+	for name, r := range provider.DataSourcesMap {
+		config.TraceDataSource(name, r)
+	}
+	for name, r := range provider.ResourcesMap {
+		config.TraceResource(name, r)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -783,29 +888,147 @@ func init() {
 
 		"delegated_project": "The name of delegated project (Identity v3).",
 
+		"assume_role": "The list of agencies to assume in order, each one is assumed by the credentials of the previous one.",
+
 		"assume_role_agency_name": "The name of agency for assume role.",
 
 		"assume_role_domain_name": "The name of domain for assume role.",
 
+		"assume_role_duration": "The duration, in seconds, of the temporary credentials obtained by assume role.",
+
+		"assume_role_project_name": "The name of the project to use after the agency is assumed.",
+
 		"cloud": "The endpoint of cloud provider, defaults to myhuaweicloud.com",
 
 		"endpoints": "The custom endpoints used to override the default endpoint URL.",
 
+		"endpoint_discovery": "The mode to build the default endpoints, the valid values are `template` and `catalog`.",
+
+		"endpoint_profile_file": "The path or contents of a JSON/YAML document which declares the endpoints of the installation.",
+
 		"regional": "Whether the service endpoints are regional",
 
 		"shared_config_file": "The path to the shared config file. If not set, the default is ~/.hcloud/config.json.",
 
 		"profile": "The profile name as set in the shared config file.",
 
+		"credential_process": "The external command which prints the access key, secret key and security token in JSON format.",
+
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
 		"enterprise_project_id": "enterprise project id",
 
+		"retry": "The retry policies of the API requests.",
+
+		"retry_service": "The retry policy of the specified service, it overrides the provider-level policy.",
+
+		"service_name": "The service catalog name, such as `rds`.",
+
+		"retry_max_attempts": "The maximum number of retries, defaults to `max_retries`.",
+
+		"retry_initial_backoff": "The wait time, in seconds, before the first retry. The wait time doubles after each retry.",
+
+		"retry_max_backoff": "The maximum wait time, in seconds, between retries.",
+
+		"retry_jitter": "Whether to randomize the wait time between retries.",
+
+		"retry_status_codes": "The HTTP status codes which are retried, defaults to `429`.",
+
+		"retry_error_codes": "The API error codes which are retried.",
+
+		"retry_non_idempotent": "Whether to retry the POST and PATCH requests with the retryable status codes.",
+
+		"rate_limit": "The client-side rate limits of the API requests.",
+
+		"rate_limit_requests_per_second": "The maximum number of requests sent per second.",
+
+		"rate_limit_burst": "The maximum number of requests sent at the same moment.",
+
+		"rate_limit_service": "The rate limit of the specified service.",
+
+		"default_tags": "The default tags which are applied to all taggable resources.",
+
+		"default_tags_tags": "The key/value pairs of the default tags, the resource-level tags with the same keys take precedence.",
+
+		"ignore_tags": "The tags which are managed outside of Terraform and ignored by all resources and data sources.",
+
+		"ignore_tags_keys": "The tag keys to be ignored.",
+
+		"ignore_tags_key_prefixes": "The prefixes of the tag keys to be ignored.",
+
+		"tracing": "The OpenTelemetry tracing of the Terraform operations and API requests.",
+
+		"tracing_endpoint": "The URL of the OTLP/HTTP traces receiver, such as `http://localhost:4318/v1/traces`.",
+
+		"tracing_headers": "The HTTP headers sent with the exported spans, such as the authentication header.",
+
+		"tracing_service_name": "The service name of the exported spans.",
+
+		"audit_log_file": "The path of the file which a JSON line is appended to for each API request.",
+
+		"audit_log_level": "The requests recorded in the audit log, the valid values are `mutating` and `all`.",
+
 		"enable_force_new": "Whether to enable ForceNew",
+
+		"quota_precheck": "Whether to fail the plan if the remaining quotas are insufficient for the planned resources.",
 	}
 }
 
-func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersion string) (interface{},
+// buildRetrySchema returns the retry policy arguments which are shared by the provider-level and service-level policy.
+func buildRetrySchema(extra map[string]*schema.Schema) map[string]*schema.Schema {
+	retrySchema := map[string]*schema.Schema{
+		"max_attempts": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_max_attempts"],
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"initial_backoff": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_initial_backoff"],
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"max_backoff": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_max_backoff"],
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"jitter": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions["retry_jitter"],
+		},
+		"retryable_status_codes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions["retry_status_codes"],
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(400, 599),
+			},
+		},
+		"retryable_error_codes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions["retry_error_codes"],
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"retry_non_idempotent": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions["retry_non_idempotent"],
+		},
+	}
+
+	for k, v := range extra {
+		retrySchema[k] = v
+	}
+	return retrySchema
+}
+
+func configureProvider(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{},
 	diag.Diagnostics) {
 	var tenantName, tenantID, delegatedProject, identityEndpoint string
 	region := d.Get("region").(string)
@@ -879,14 +1102,18 @@ func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersi
 			SecurityKeyLock:     new(sync.Mutex),
 		},
 
-		EnableForceNew: d.Get("enable_force_new").(bool),
+		CredentialProcess: d.Get("credential_process").(string),
+		EnableForceNew:    d.Get("enable_force_new").(bool),
+		QuotaPrecheck:     d.Get("quota_precheck").(bool),
+		PlanCache:         config.NewPlanCache(),
+		Recorder:          config.RecorderFromContext(ctx),
 	}
 
 	// Save hcsConfig to config.Config for extend
 	hcsConfig.Metadata = &hcsConfig
+	hcsConfig.ScopedProjectIDMap = make(map[string]string)
 
 	// get assume role
-	// This config version does not support []config.AssumeRole, so only the length is 1 of assume_role can be supported
 	assumeRoleList := d.Get("assume_role").([]interface{})
 	if len(assumeRoleList) == 0 {
 		// without assume_role block in provider
@@ -897,54 +1124,102 @@ func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersi
 			hcsConfig.AssumeRoleAgency = delegatedAgencyName
 			hcsConfig.AssumeRoleDomain = delegatedDomainName
 		}
-	} else if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		hcsConfig.AssumeRoleAgency = assumeRole["agency_name"].(string)
-		hcsConfig.AssumeRoleDomain = assumeRole["domain_name"].(string)
+	} else {
+		hcsConfig.AssumeRoles = flattenProviderAssumeRoles(assumeRoleList)
+		// the last agency is the identity which the resources are managed with
+		lastRole := hcsConfig.AssumeRoles[len(hcsConfig.AssumeRoles)-1]
+		hcsConfig.AssumeRoleAgency = lastRole.AgencyName
+		hcsConfig.AssumeRoleDomain = lastRole.DomainName
 	}
 
-	// get custom endpoints
-	endpoints, err := flattenProviderEndpoints(d)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
+	// get retry policies
+	if retryList := d.Get("retry").([]interface{}); len(retryList) > 0 && retryList[0] != nil {
+		retry := retryList[0].(map[string]interface{})
+		retryPolicy := flattenProviderRetryPolicy(retry)
+		hcsConfig.RetryPolicy = &retryPolicy
 
-	// set default endpoints
-	if _, ok := endpoints["csms"]; !ok {
-		endpoints["csms"] = fmt.Sprintf("https://csms-scc-apig.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
-	}
-	if _, ok := endpoints["cfw"]; !ok {
-		endpoints["cfw"] = fmt.Sprintf("https://cfwforhcs-api.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
-	}
-	if _, ok := endpoints["hss"]; !ok {
-		endpoints["hss"] = fmt.Sprintf("https://hss-api.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+		hcsConfig.ServiceRetryPolicies = make(map[string]config.RetryPolicy)
+		for _, v := range retry["service"].([]interface{}) {
+			service := v.(map[string]interface{})
+			hcsConfig.ServiceRetryPolicies[service["name"].(string)] = flattenProviderRetryPolicy(service)
+		}
 	}
-	if _, ok := endpoints["kms"]; !ok {
-		endpoints["kms"] = fmt.Sprintf("https://kms-scc-apig.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+
+	// get rate limiters
+	if rateLimitList := d.Get("rate_limit").([]interface{}); len(rateLimitList) > 0 && rateLimitList[0] != nil {
+		rateLimit := rateLimitList[0].(map[string]interface{})
+		if rate := rateLimit["requests_per_second"].(float64); rate > 0 {
+			hcsConfig.RateLimiter = config.NewRateLimiter("provider", rate, rateLimit["burst"].(int))
+		}
+
+		hcsConfig.ServiceRateLimiters = make(map[string]*config.RateLimiter)
+		for _, v := range rateLimit["service"].([]interface{}) {
+			service := v.(map[string]interface{})
+			name := service["name"].(string)
+			hcsConfig.ServiceRateLimiters[name] = config.NewRateLimiter(name, service["requests_per_second"].(float64),
+				service["burst"].(int))
+		}
 	}
-	if _, ok := endpoints["obs"]; !ok {
-		endpoints["obs"] = fmt.Sprintf("https://obsv3.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+
+	// get default tags, they are merged into the tags of the resources
+	defaultTags := make(map[string]string)
+	if v, ok := d.GetOk("default_tags.0.tags"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			defaultTags[key] = value.(string)
+		}
 	}
-	if _, ok := endpoints["opengauss"]; !ok {
-		openGaussUrl := "https://gaussdb.%s.%s/gaussdb/"
-		endpoints["opengauss"] = fmt.Sprintf(openGaussUrl, hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+	hcsConfig.DefaultTags = defaultTags
+
+	// get ignored tags, they are removed from the tags read from the API
+	hcsConfig.IgnoreTags = utils.IgnoreTagsConfig{
+		Keys:        utils.ExpandToStringListBySet(d.Get("ignore_tags.0.keys").(*schema.Set)),
+		KeyPrefixes: utils.ExpandToStringListBySet(d.Get("ignore_tags.0.key_prefixes").(*schema.Set)),
 	}
-	if _, ok := endpoints["opengaussv31"]; !ok {
-		openGaussUrl := "https://gaussdb.%s.%s/gaussdb/"
-		endpoints["opengaussv31"] = fmt.Sprintf(openGaussUrl, hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+
+	// get tracer, the spans are exported to the OTLP receiver specified in the provider block or by the env vars
+	if tracingList := d.Get("tracing").([]interface{}); len(tracingList) > 0 && tracingList[0] != nil {
+		tracing := tracingList[0].(map[string]interface{})
+		headers := make(map[string]string)
+		for key, value := range tracing["headers"].(map[string]interface{}) {
+			headers[key] = value.(string)
+		}
+		tracer, err := config.NewTracer(tracing["endpoint"].(string), headers, tracing["service_name"].(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		hcsConfig.Tracer = tracer
+	} else {
+		tracer, err := config.NewTracerFromEnv()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		hcsConfig.Tracer = tracer
 	}
-	if _, ok := endpoints["secmaster"]; !ok {
-		endpoints["secmaster"] = fmt.Sprintf("https://secmaster-tenant.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+
+	// get audit logger, the requests sent when loading the config below are recorded as well
+	if path := d.Get("audit_log_file").(string); path != "" {
+		auditLogger, err := config.NewAuditLogger(path, d.Get("audit_log_level").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		hcsConfig.AuditLogger = auditLogger
 	}
-	if _, ok := endpoints["swr"]; !ok {
-		endpoints["swr"] = fmt.Sprintf("https://swr-api.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
+
+	// get custom endpoints
+	endpoints, err := flattenProviderEndpoints(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
-	if _, ok := endpoints["waf"]; !ok {
-		wafEndpoint := fmt.Sprintf("https://waf-api.%s.%s/", hcsConfig.Config.Region, hcsConfig.Config.Cloud)
-		endpoints["waf"] = wafEndpoint
-		endpoints["waf-dedicated"] = wafEndpoint
+
+	hcsConfig.EndpointDiscovery = d.Get("endpoint_discovery").(string)
+	hcsConfig.EndpointProfileFile = d.Get("endpoint_profile_file").(string)
+	hcsConfig.UserEndpoints = make(map[string]string, len(endpoints))
+	for k, v := range endpoints {
+		hcsConfig.UserEndpoints[k] = v
 	}
 
+	// the default endpoints are templated with the cloud of the endpoint profile in LoadAndValidate,
+	// they will be overridden by the endpoints discovered from the service catalog
 	hcsConfig.Endpoints = endpoints
 	if err := hcsConfig.LoadAndValidate(); err != nil {
 		return nil, diag.FromErr(err)
@@ -953,6 +1228,32 @@ func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersi
 	return &hcsConfig.Config, nil
 }
 
+func flattenProviderAssumeRoles(assumeRoleList []interface{}) []config.AssumeRole {
+	assumeRoles := make([]config.AssumeRole, 0, len(assumeRoleList))
+	for _, v := range assumeRoleList {
+		assumeRole := v.(map[string]interface{})
+		assumeRoles = append(assumeRoles, config.AssumeRole{
+			AgencyName:  assumeRole["agency_name"].(string),
+			DomainName:  assumeRole["domain_name"].(string),
+			ProjectName: assumeRole["project_name"].(string),
+			Duration:    assumeRole["duration"].(int),
+		})
+	}
+	return assumeRoles
+}
+
+func flattenProviderRetryPolicy(policy map[string]interface{}) config.RetryPolicy {
+	return config.RetryPolicy{
+		MaxAttempts:          policy["max_attempts"].(int),
+		InitialBackoff:       time.Duration(policy["initial_backoff"].(int)) * time.Second,
+		MaxBackoff:           time.Duration(policy["max_backoff"].(int)) * time.Second,
+		Jitter:               policy["jitter"].(bool),
+		RetryableStatusCodes: utils.ExpandToIntList(policy["retryable_status_codes"].([]interface{})),
+		RetryableErrorCodes:  utils.ExpandToStringList(policy["retryable_error_codes"].([]interface{})),
+		RetryNonIdempotent:   policy["retry_non_idempotent"].(bool),
+	}
+}
+
 func flattenProviderEndpoints(d *schema.ResourceData) (map[string]string, error) {
 	endpoints := d.Get("endpoints").(map[string]interface{})
 	epMap := make(map[string]string)
@@ -0,0 +1,176 @@
+package huaweicloudstack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/aom"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cfw"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dcs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dew"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dws"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lts"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/obs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/secmaster"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/servicestage"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/sfs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ucs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/waf"
+)
+
+// upstreamDataSources returns the data sources implemented in terraform-provider-huaweicloud, their service clients
+// are created by the config of the upstream provider, so they are not project-scoped.
+func upstreamDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"hcs_cce_cluster":        cce.DataSourceCCEClusterV3(),
+		"hcs_cce_clusters":       cce.DataSourceCCEClusters(),
+		"hcs_cce_addon_template": cce.DataSourceAddonTemplate(),
+		"hcs_cce_node_pool":      cce.DataSourceCCENodePoolV3(),
+		"hcs_cce_node":           cce.DataSourceNode(),
+		"hcs_cce_nodes":          cce.DataSourceNodes(),
+
+		"hcs_cfw_firewalls": cfw.DataSourceFirewalls(),
+
+		"hcs_dcs_flavors":         dcs.DataSourceDcsFlavorsV2(),
+		"hcs_dcs_instances":       dcs.DataSourceDcsInstance(),
+		"hcs_dcs_templates":       dcs.DataSourceTemplates(),
+		"hcs_dcs_template_detail": dcs.DataSourceTemplateDetail(),
+
+		"hcs_kms_key":      dew.DataSourceKmsKey(),
+		"hcs_kms_data_key": dew.DataSourceKmsDataKeyV1(),
+
+		"hcs_dms_kafka_instances": dms.DataSourceDmsKafkaInstances(),
+		"hcs_dms_kafka_flavors":   dms.DataSourceKafkaFlavors(),
+		"hcs_dms_maintainwindow":  dms.DataSourceDmsMaintainWindow(),
+
+		"hcs_dws_flavors": dws.DataSourceDwsFlavors(),
+
+		"hcs_mrs_clusters": mrs.DataSourceMrsClusters(),
+		"hcs_mrs_versions": mrs.DataSourceMrsVersions(),
+
+		"hcs_obs_buckets":       obs.DataSourceObsBuckets(),
+		"hcs_obs_bucket_object": obs.DataSourceObsBucketObject(),
+
+		"hcs_rds_pg_plugins": rds.DataSourcePgPlugins(),
+
+		"hcs_sfs_file_system": sfs.DataSourceSFSFileSystemV2(),
+
+		"hcs_sfs_turbos": sfs.DataSourceTurbos(),
+
+		"hcs_waf_certificate":         waf.DataSourceWafCertificateV1(),
+		"hcs_waf_dedicated_instances": waf.DataSourceWafDedicatedInstancesV1(),
+		"hcs_waf_policies":            waf.DataSourceWafPoliciesV1(),
+		"hcs_waf_reference_tables":    waf.DataSourceWafReferenceTablesV1(),
+	}
+}
+
+// upstreamResources returns the resources implemented in terraform-provider-huaweicloud, their service clients are
+// created by the config of the upstream provider, so they are not project-scoped.
+func upstreamResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"hcs_aom_alarm_rule":             aom.ResourceAlarmRule(),
+		"hcs_aom_service_discovery_rule": aom.ResourceServiceDiscoveryRule(),
+
+		"hcs_cce_addon":       cce.ResourceAddon(),
+		"hcs_cce_cluster":     cce.ResourceCluster(),
+		"hcs_cce_namespace":   cce.ResourceCCENamespaceV1(),
+		"hcs_cce_node":        cce.ResourceNode(),
+		"hcs_cce_node_attach": cce.ResourceNodeAttach(),
+		"hcs_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
+
+		"hcs_cfw_address_group":        cfw.ResourceAddressGroup(),
+		"hcs_cfw_black_white_list":     cfw.ResourceBlackWhiteList(),
+		"hcs_cfw_eip_protection":       cfw.ResourceEipProtection(),
+		"hcs_cfw_service_group_member": cfw.ResourceServiceGroupMember(),
+		"hcs_cfw_service_group":        cfw.ResourceServiceGroup(),
+
+		"hcs_dcs_backup": dcs.ResourceDcsBackup(),
+
+		"hcs_kms_key":   dew.ResourceKmsKey(),
+		"hcs_kms_grant": dew.ResourceKmsGrant(),
+
+		"hcs_dms_kafka_consumer_group": dms.ResourceDmsKafkaConsumerGroup(),
+		"hcs_dms_kafka_permissions":    dms.ResourceDmsKafkaPermissions(),
+		"hcs_dms_kafka_topic":          dms.ResourceDmsKafkaTopic(),
+		"hcs_dms_kafka_user":           dms.ResourceDmsKafkaUser(),
+
+		"hcs_dms_rocketmq_user": dms.ResourceDmsRocketMQUser(),
+
+		"hcs_dws_cluster":            dws.ResourceDwsCluster(),
+		"hcs_dws_alarm_subscription": dws.ResourceDwsAlarmSubs(),
+		"hcs_dws_event_subscription": dws.ResourceDwsEventSubs(),
+		"hcs_dws_ext_data_source":    dws.ResourceDwsExtDataSource(),
+		"hcs_dws_snapshot":           dws.ResourceDwsSnapshot(),
+		"hcs_dws_snapshot_policy":    dws.ResourceDwsSnapshotPolicy(),
+
+		"hcs_lts_host_access":               lts.ResourceHostAccessConfig(),
+		"hcs_lts_host_group":                lts.ResourceHostGroup(),
+		"hcs_lts_search_criteria":           lts.ResourceSearchCriteria(),
+		"hcs_lts_stream":                    lts.ResourceLTSStream(),
+		"hcs_lts_structuring_configuration": lts.ResourceStructConfig(),
+		"hcs_lts_transfer":                  lts.ResourceLtsTransfer(),
+
+		"hcs_mrs_job": mrs.ResourceMRSJobV2(),
+
+		"hcs_obs_bucket_object":     obs.ResourceObsBucketObject(),
+		"hcs_obs_bucket_object_acl": obs.ResourceOBSBucketObjectAcl(),
+		"hcs_obs_bucket_policy":     obs.ResourceObsBucketPolicy(),
+
+		// rds common
+		"hcs_rds_instance":  rds.ResourceRdsInstance(),
+		"hcs_rds_sql_audit": rds.ResourceSQLAudit(),
+
+		// rds PostgreSQL
+		"hcs_rds_pg_account":  rds.ResourcePgAccount(),
+		"hcs_rds_pg_database": rds.ResourcePgDatabase(),
+		"hcs_rds_pg_plugin":   rds.ResourceRdsPgPlugin(),
+
+		// rds MySQL
+		"hcs_rds_mysql_account":            rds.ResourceMysqlAccount(),
+		"hcs_rds_mysql_database":           rds.ResourceMysqlDatabase(),
+		"hcs_rds_mysql_database_privilege": rds.ResourceMysqlDatabasePrivilege(),
+
+		"hcs_secmaster_alert_rule":       secmaster.ResourceAlertRule(),
+		"hcs_secmaster_playbook":         secmaster.ResourcePlaybook(),
+		"hcs_secmaster_playbook_action":  secmaster.ResourcePlaybookAction(),
+		"hcs_secmaster_playbook_version": secmaster.ResourcePlaybookVersion(),
+		"hcs_secmaster_playbook_rule":    secmaster.ResourcePlaybookRule(),
+
+		"hcs_servicestage_application": servicestage.ResourceApplication(),
+
+		"hcs_sfs_file_system": sfs.ResourceSFSFileSystemV2(),
+
+		"hcs_sfs_turbo_dir":       sfs.ResourceSfsTurboDir(),
+		"hcs_sfs_turbo_dir_quota": sfs.ResourceSfsTurboDirQuota(),
+
+		"hcs_swr_image_retention_policy":   swr.ResourceSwrImageRetentionPolicy(),
+		"hcs_swr_image_trigger":            swr.ResourceSwrImageTrigger(),
+		"hcs_swr_organization":             swr.ResourceSWROrganization(),
+		"hcs_swr_organization_permissions": swr.ResourceSWROrganizationPermissions(),
+		"hcs_swr_repository":               swr.ResourceSWRRepository(),
+		"hcs_swr_repository_sharing":       swr.ResourceSWRRepositorySharing(),
+
+		"hcs_ucs_cluster": ucs.ResourceCluster(),
+		"hcs_ucs_fleet":   ucs.ResourceFleet(),
+		"hcs_ucs_policy":  ucs.ResourcePolicy(),
+
+		"hcs_waf_address_group":                       waf.ResourceWafAddressGroup(),
+		"hcs_waf_certificate":                         waf.ResourceWafCertificateV1(),
+		"hcs_waf_dedicated_domain":                    waf.ResourceWafDedicatedDomain(),
+		"hcs_waf_policy":                              waf.ResourceWafPolicyV1(),
+		"hcs_waf_reference_table":                     waf.ResourceWafReferenceTableV1(),
+		"hcs_waf_rule_blacklist":                      waf.ResourceWafRuleBlackListV1(),
+		"hcs_waf_rule_cc_protection":                  waf.ResourceRuleCCProtection(),
+		"hcs_waf_rule_data_masking":                   waf.ResourceWafRuleDataMaskingV1(),
+		"hcs_waf_rule_geolocation_access_control":     waf.ResourceRuleGeolocation(),
+		"hcs_waf_rule_known_attack_source":            waf.ResourceRuleKnownAttack(),
+		"hcs_waf_rule_global_protection_whitelist":    waf.ResourceRuleGlobalProtectionWhitelist(),
+		"hcs_waf_rule_information_leakage_prevention": waf.ResourceRuleLeakagePrevention(),
+		"hcs_waf_rule_precise_protection":             waf.ResourceRulePreciseProtection(),
+		"hcs_waf_rule_web_tamper_protection":          waf.ResourceWafRuleWebTamperProtectionV1(),
+	}
+}
@@ -207,6 +207,14 @@ func Get(client *golangsdk.ServiceClient, id string, opts ListOptsBuilder) (r Ge
 	return
 }
 
+// GetBareMetalServer retrieves a particular Server by the BMS API, the client should be the BMS client.
+func GetBareMetalServer(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(showURL(client, id), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
 func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r JobResult) {
 	reqBody, err := opts.ToServerDeleteMap()
 	if err != nil {
@@ -216,3 +224,131 @@ func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r JobResult) {
 	_, r.Err = client.Post(deleteURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
 	return
 }
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServerUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes that may be updated on an existing server.
+type UpdateOpts struct {
+	Name string `json:"name" required:"true"`
+}
+
+// ToServerUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToServerUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "server")
+}
+
+// Update requests that the name of the indicated server be changed.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(putURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ChangeAdminPassword resets the password of the administrator, the server must be running.
+func ChangeAdminPassword(client *golangsdk.ServiceClient, id, newPassword string) (r PasswordResult) {
+	b := map[string]interface{}{
+		"reset-password": map[string]string{
+			"new_password": newPassword,
+		},
+	}
+	_, r.Err = client.Put(passwordURL(client, id), b, nil, &golangsdk.RequestOpts{OkCodes: []int{204}})
+	return
+}
+
+// AttachVolumeOpts specifies the volume to be attached to the server.
+type AttachVolumeOpts struct {
+	VolumeID string `json:"volumeId" required:"true"`
+	// The device name is assigned by the system if it is left blank.
+	Device string `json:"device,omitempty"`
+}
+
+// AttachVolume attaches a volume to the server.
+func AttachVolume(client *golangsdk.ServiceClient, id string, opts AttachVolumeOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "volumeAttachment")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(attachVolumeURL(client, id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// DetachVolume detaches a volume from the server.
+func DetachVolume(client *golangsdk.ServiceClient, id, volumeID string) (r JobResult) {
+	_, r.Err = client.DeleteWithResponse(detachVolumeURL(client, id, volumeID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// AddNic specifies a NIC to be added to the server.
+type AddNic struct {
+	SubnetId       string          `json:"subnet_id" required:"true"`
+	SecurityGroups []SecurityGroup `json:"security_groups,omitempty"`
+	IpAddress      string          `json:"ip_address,omitempty"`
+}
+
+// AddNicsOpts specifies the NICs to be added to the server.
+type AddNicsOpts struct {
+	Nics []AddNic `json:"nics" required:"true"`
+}
+
+// AddNics adds the NICs to the server.
+func AddNics(client *golangsdk.ServiceClient, id string, opts AddNicsOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(addNicsURL(client, id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// DeleteNic specifies a NIC to be deleted by its port ID.
+type DeleteNic struct {
+	Id string `json:"id" required:"true"`
+}
+
+// DeleteNicsOpts specifies the NICs to be deleted from the server.
+type DeleteNicsOpts struct {
+	Nics []DeleteNic `json:"nics" required:"true"`
+}
+
+// DeleteNics deletes the NICs from the server, the primary NIC can not be deleted.
+func DeleteNics(client *golangsdk.ServiceClient, id string, opts DeleteNicsOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(deleteNicsURL(client, id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// PowerOpts specifies the servers and the type of the power action.
+type PowerOpts struct {
+	// The type of the stop and reboot actions, the valid values are SOFT and HARD.
+	Type    string   `json:"type,omitempty"`
+	Servers []Server `json:"servers" required:"true"`
+}
+
+// PowerAction starts, stops or reboots the servers, the action must be os-start, os-stop or reboot.
+func PowerAction(client *golangsdk.ServiceClient, opts PowerOpts, action string) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, action)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
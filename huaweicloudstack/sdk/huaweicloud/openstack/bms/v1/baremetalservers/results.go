@@ -46,6 +46,7 @@ type Metadata struct {
 	ResourceType     string `json:"metering.resourcetype"`
 	ImageName        string `json:"image_name"`
 	OpSvcUserId      string `json:"op_svc_userid"`
+	AgencyName       string `json:"agency_name"`
 	OsType           string `json:"os_type"`
 	BmsSupportEvs    string `json:"__bms_support_evs"`
 	OsBit            string `json:"os_bit"`
@@ -139,3 +140,9 @@ func (r cloudServerResult) Extract() (*CloudServer, error) {
 	err := r.ExtractInto(&s)
 	return s.Server, err
 }
+
+// PasswordResult is the response from a ChangeAdminPassword operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type PasswordResult struct {
+	golangsdk.ErrResult
+}
@@ -10,6 +10,10 @@ func getURL(sc *golangsdk.ServiceClient, serverID string) string {
 	return sc.ServiceURL("cloudservers", serverID)
 }
 
+func showURL(sc *golangsdk.ServiceClient, serverID string) string {
+	return sc.ServiceURL("baremetalservers", serverID)
+}
+
 func putURL(sc *golangsdk.ServiceClient, serverID string) string {
 	return sc.ServiceURL("baremetalservers", serverID)
 }
@@ -21,3 +25,27 @@ func deleteURL(sc *golangsdk.ServiceClient) string {
 func jobURL(sc *golangsdk.ServiceClient, jobId string) string {
 	return sc.ServiceURL("jobs", jobId)
 }
+
+func passwordURL(sc *golangsdk.ServiceClient, serverID string) string {
+	return sc.ServiceURL("baremetalservers", serverID, "os-reset-password")
+}
+
+func attachVolumeURL(sc *golangsdk.ServiceClient, serverID string) string {
+	return sc.ServiceURL("baremetalservers", serverID, "attachvolume")
+}
+
+func detachVolumeURL(sc *golangsdk.ServiceClient, serverID, volumeID string) string {
+	return sc.ServiceURL("baremetalservers", serverID, "detachvolume", volumeID)
+}
+
+func addNicsURL(sc *golangsdk.ServiceClient, serverID string) string {
+	return sc.ServiceURL("baremetalservers", serverID, "nics")
+}
+
+func deleteNicsURL(sc *golangsdk.ServiceClient, serverID string) string {
+	return sc.ServiceURL("baremetalservers", serverID, "nics/delete")
+}
+
+func actionURL(sc *golangsdk.ServiceClient) string {
+	return sc.ServiceURL("baremetalservers/action")
+}
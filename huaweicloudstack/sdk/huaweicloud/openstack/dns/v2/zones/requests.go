@@ -30,6 +30,8 @@ type ListOpts struct {
 	Status      string `q:"status"`
 	TTL         int    `q:"ttl"`
 	Type        string `q:"type"`
+
+	EnterpriseProjectID string `q:"enterprise_project_id"`
 }
 
 // ToZoneListQuery formats a ListOpts into a query string.
@@ -90,6 +90,9 @@ type OsExtraSpecs struct {
 	OperationAz string `json:"cond:operation:az"`
 
 	ExtBootType string `json:"huawei:extBootType"`
+
+	// Indicates the CPU architecture of the flavor, the value can be x86 or arm64.
+	InstanceArchitecture string `json:"ecs:instance_architecture"`
 }
 
 // FlavorsPage is the page returned by a pager when traversing over a
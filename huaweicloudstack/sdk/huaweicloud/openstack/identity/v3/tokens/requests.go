@@ -200,3 +200,13 @@ func Get(c *golangsdk.ServiceClient, token string) (r GetResult) {
 	}
 	return
 }
+
+// GetCatalog retrieves the service catalog which is available to the current token (or AK/SK).
+func GetCatalog(c *golangsdk.ServiceClient) (r CatalogResult) {
+	resp, err := c.Get(catalogURL(c), &r.Body, nil)
+	r.Err = err
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	return
+}
@@ -168,3 +168,16 @@ type Token struct {
 func (r commonResult) ExtractInto(v interface{}) error {
 	return r.ExtractIntoStructPtr(v, "token")
 }
+
+// CatalogResult is the response from a GetCatalog request. Use ExtractServiceCatalog()
+// to interpret it as a service catalog.
+type CatalogResult struct {
+	golangsdk.Result
+}
+
+// ExtractServiceCatalog returns the ServiceCatalog of the current token.
+func (r CatalogResult) ExtractServiceCatalog() (*ServiceCatalog, error) {
+	var s ServiceCatalog
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -5,3 +5,7 @@ import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huawe
 func tokenURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("auth", "tokens")
 }
+
+func catalogURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("auth", "catalog")
+}
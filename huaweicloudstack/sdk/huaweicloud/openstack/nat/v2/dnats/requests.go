@@ -1,6 +1,9 @@
 package dnats
 
-import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
 
 // CreateOpts is the structure used to create a new DNAT rule.
 type CreateOpts struct {
@@ -53,6 +56,58 @@ func Get(c *golangsdk.ServiceClient, gatewayId string) (*Rule, error) {
 	return &r.Rule, err
 }
 
+// ListOpts allows to filter the DNAT rule list using given parameters.
+type ListOpts struct {
+	// The ID of the DNAT rule.
+	ID string `q:"id"`
+	// The ID of the gateway to which the DNAT rule belongs.
+	GatewayId string `q:"nat_gateway_id"`
+	// The protocol type. The valid values are 'udp', 'tcp' and 'any'.
+	Protocol string `q:"protocol"`
+	// The port ID of network.
+	PortId string `q:"port_id"`
+	// The private IP address of a user.
+	PrivateIp string `q:"private_ip"`
+	// The port used by ECSs or BMSs to provide services for external systems.
+	InternalServicePort *int `q:"internal_service_port"`
+	// The port used by Floating IP provide services for external systems.
+	ExternalServicePort *int `q:"external_service_port"`
+	// The ID of floating IP connected by DNAT rule.
+	FloatingIpId string `q:"floating_ip_id"`
+	// The floating IP address connected by DNAT rule.
+	FloatingIpAddress string `q:"floating_ip_address"`
+	// The description of the DNAT rule.
+	Description string `q:"description"`
+	// The current status of the DNAT rule.
+	Status string `q:"status"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last DNAT rule on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all DNAT rules using given parameters.
+func List(client *golangsdk.ServiceClient, opts ListOpts) ([]Rule, error) {
+	url := rootURL(client)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := RulePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractRules(pages)
+}
+
 // UpdateOpts is the structure used to modify an existing DNAT rule.
 type UpdateOpts struct {
 	// The ID of the gateway to which the DNAT rule belongs.
@@ -1,5 +1,9 @@
 package dnats
 
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
 // Rule is a struct that represents the DNAT rule detail.
 type Rule struct {
 	// The ID of the DNAT rule.
@@ -50,3 +54,33 @@ type updateResp struct {
 	// The DNAT rule detail.
 	Rule Rule `json:"dnat_rule"`
 }
+
+// RulePage is a single page of the DNAT rule list, which is paginated by the marker.
+type RulePage struct {
+	pagination.MarkerPageBase
+}
+
+// LastMarker returns the ID of the last DNAT rule in the page.
+func (p RulePage) LastMarker() (string, error) {
+	rules, err := ExtractRules(p)
+	if err != nil {
+		return "", err
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	return rules[len(rules)-1].ID, nil
+}
+
+// IsEmpty checks whether the page has no DNAT rules.
+func (p RulePage) IsEmpty() (bool, error) {
+	rules, err := ExtractRules(p)
+	return len(rules) == 0, err
+}
+
+// ExtractRules extracts the DNAT rules from the pages.
+func ExtractRules(r pagination.Page) ([]Rule, error) {
+	var s []Rule
+	err := r.(RulePage).Result.ExtractIntoSlicePtr(&s, "dnat_rules")
+	return s, err
+}
@@ -2,6 +2,7 @@ package snats
 
 import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
 )
 
 // CreateOpts is the structure that used to create a new SNAT rule.
@@ -50,6 +51,54 @@ func Get(c *golangsdk.ServiceClient, ruleId string) (*Rule, error) {
 	return &r.Rule, err
 }
 
+// ListOpts allows to filter the SNAT rule list using given parameters.
+type ListOpts struct {
+	// The ID of the SNAT rule.
+	ID string `q:"id"`
+	// The ID of the gateway to which the SNAT rule belongs.
+	GatewayId string `q:"nat_gateway_id"`
+	// The network ID of subnet connected by SNAT rule (VPC side).
+	NetworkId string `q:"network_id"`
+	// The CIDR block connected by SNAT rule (DC side).
+	Cidr string `q:"cidr"`
+	// The resource type of the SNAT rule.
+	SourceType *int `q:"source_type"`
+	// The ID of the floating IP connected by SNAT rule.
+	FloatingIpId string `q:"floating_ip_id"`
+	// The floating IP address connected by SNAT rule.
+	FloatingIpAddress string `q:"floating_ip_address"`
+	// The description of the SNAT rule.
+	Description string `q:"description"`
+	// The status of the SNAT rule.
+	Status string `q:"status"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last SNAT rule on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all SNAT rules using given parameters.
+func List(client *golangsdk.ServiceClient, opts ListOpts) ([]Rule, error) {
+	url := rootURL(client)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := RulePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractRules(pages)
+}
+
 // CreateOpts is the structure that used to update the configuration of the SNAT rule.
 type UpdateOpts struct {
 	// The ID of the gateway to which the SNAT rule belongs.
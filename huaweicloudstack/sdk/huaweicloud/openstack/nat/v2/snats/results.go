@@ -1,5 +1,9 @@
 package snats
 
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
 // Rule is a struct that represents the SNAT rule detail.
 type Rule struct {
 	// The ID of SNAT rule.
@@ -27,6 +31,8 @@ type Rule struct {
 	// + 0: VPC side.
 	// + 1: DC side.
 	SourceType int `json:"source_type"`
+	// The creation time of the SNAT rule.
+	CreatedAt string `json:"created_at"`
 }
 
 type createResp struct {
@@ -43,3 +49,33 @@ type updateResp struct {
 	// The SNAT rule detail.
 	Rule Rule `json:"snat_rule"`
 }
+
+// RulePage is a single page of the SNAT rule list, which is paginated by the marker.
+type RulePage struct {
+	pagination.MarkerPageBase
+}
+
+// LastMarker returns the ID of the last SNAT rule in the page.
+func (p RulePage) LastMarker() (string, error) {
+	rules, err := ExtractRules(p)
+	if err != nil {
+		return "", err
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	return rules[len(rules)-1].ID, nil
+}
+
+// IsEmpty checks whether the page has no SNAT rules.
+func (p RulePage) IsEmpty() (bool, error) {
+	rules, err := ExtractRules(p)
+	return len(rules) == 0, err
+}
+
+// ExtractRules extracts the SNAT rules from the pages.
+func ExtractRules(r pagination.Page) ([]Rule, error) {
+	var s []Rule
+	err := r.(RulePage).Result.ExtractIntoSlicePtr(&s, "snat_rules")
+	return s, err
+}
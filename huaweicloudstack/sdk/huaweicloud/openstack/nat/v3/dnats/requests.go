@@ -1,6 +1,9 @@
 package dnats
 
-import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
 
 // CreateOpts is the structure used to create a new private DNAT rule.
 type CreateOpts struct {
@@ -51,6 +54,58 @@ func Get(c *golangsdk.ServiceClient, ruleId string) (*Rule, error) {
 	return &r.Rule, err
 }
 
+// ListOpts allows to filter the private DNAT rule list using given parameters.
+type ListOpts struct {
+	// The ID of the private DNAT rule.
+	ID string `q:"id"`
+	// The ID of the gateway to which the private DNAT rule belongs.
+	GatewayId string `q:"gateway_id"`
+	// The ID of the transit IP for private NAT.
+	TransitIpId string `q:"transit_ip_id"`
+	// The network interface ID of the backend instance.
+	NetworkInterfaceId string `q:"network_interface_id"`
+	// The backend type of the private DNAT rule.
+	Type string `q:"type"`
+	// The protocol type of the private DNAT rule.
+	Protocol string `q:"protocol"`
+	// The private IP address of the backend instance.
+	PrivateIpAddress string `q:"private_ip_address"`
+	// The port of the backend instance.
+	InternalServicePort string `q:"internal_service_port"`
+	// The port of the transit IP.
+	TransitServicePort string `q:"transit_service_port"`
+	// The description of the private DNAT rule.
+	Description string `q:"description"`
+	// The ID of the enterprise project to which the private DNAT rule belongs.
+	EnterpriseProjectId string `q:"enterprise_project_id"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last private DNAT rule on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all private DNAT rules using given parameters.
+func List(c *golangsdk.ServiceClient, opts ListOpts) ([]Rule, error) {
+	url := rootURL(c)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := RulePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return extractRules(pages)
+}
+
 // UpdateOpts is the structure used to modify an existing private DNAT rule.
 type UpdateOpts struct {
 	// The ID of the transit IP for private NAT.
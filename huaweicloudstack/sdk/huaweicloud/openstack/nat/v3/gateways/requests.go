@@ -3,6 +3,7 @@ package gateways
 import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
 )
 
 // CreateOpts is the structure used to create a new private NAT gateway.
@@ -34,7 +35,10 @@ type CreateOpts struct {
 
 // DownLinkVpc is an object that represents the subnet configuration to which private NAT gateway belongs.
 type DownLinkVpc struct {
+	// The ID of the subnet.
 	SubnetId string `json:"virsubnet_id" required:"true"`
+	// The ID of the VPC to which the subnet belongs, only returned in the response.
+	VpcId string `json:"vpc_id,omitempty"`
 }
 
 var requestOpts = golangsdk.RequestOpts{
@@ -64,6 +68,52 @@ func Get(c *golangsdk.ServiceClient, gatewayId string) (*Gateway, error) {
 	return &r.Gateway, err
 }
 
+// ListOpts allows to filter the private NAT gateway list using given parameters.
+type ListOpts struct {
+	// The ID of the private NAT gateway.
+	ID string `q:"id"`
+	// The name of the private NAT gateway.
+	Name string `q:"name"`
+	// The description of the private NAT gateway.
+	Description string `q:"description"`
+	// The specification of the private NAT gateway.
+	Spec string `q:"spec"`
+	// The current status of the private NAT gateway.
+	Status string `q:"status"`
+	// The ID of the VPC to which the private NAT gateway belongs.
+	VpcId string `q:"vpc_id"`
+	// The ID of the subnet to which the private NAT gateway belongs.
+	SubnetId string `q:"virsubnet_id"`
+	// The enterprise project ID to which the private NAT gateway belongs.
+	EnterpriseProjectId string `q:"enterprise_project_id"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last private NAT gateway on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all private NAT gateways using given parameters.
+func List(c *golangsdk.ServiceClient, opts ListOpts) ([]Gateway, error) {
+	url := rootURL(c)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := GatewayPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return extractGateways(pages)
+}
+
 // UpdateOpts is the structure used to modify an existing private NAT gateway.
 type UpdateOpts struct {
 	// The name of the private NAT gateway.
@@ -1,6 +1,9 @@
 package gateways
 
-import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
 
 // Gateway is the structure represents the private NAT gateway details.
 type Gateway struct {
@@ -53,3 +56,43 @@ type updateResp struct {
 	// The gateway detail.
 	Gateway Gateway `json:"gateway"`
 }
+
+type listResp struct {
+	// The list of the gateway details.
+	Gateways []Gateway `json:"gateways"`
+	// The page information.
+	PageInfo pageInfo `json:"page_info"`
+}
+
+// pageInfo is the structure that represents the page information.
+type pageInfo struct {
+	// The marker of the next page.
+	NextMarker string `json:"next_marker"`
+	// The number of the private NAT gateways in current page.
+	CurrentCount int `json:"current_count"`
+}
+
+// GatewayPage represents the response pages of the List method.
+type GatewayPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if the page has no private NAT gateways.
+func (r GatewayPage) IsEmpty() (bool, error) {
+	resp, err := extractGateways(r)
+	return len(resp) == 0, err
+}
+
+// LastMarker returns the marker of the next page, it is empty if the current page is the last one.
+func (r GatewayPage) LastMarker() (string, error) {
+	var s listResp
+	err := r.Result.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+// extractGateways is a method which to extract the response to a private NAT gateway list.
+func extractGateways(r pagination.Page) ([]Gateway, error) {
+	var s listResp
+	err := r.(GatewayPage).Result.ExtractInto(&s)
+	return s.Gateways, err
+}
@@ -1,6 +1,9 @@
 package snats
 
-import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
 
 // CreateOpts is the structure used to create a new private SNAT rule.
 type CreateOpts struct {
@@ -46,6 +49,52 @@ func Get(c *golangsdk.ServiceClient, ruleId string) (*Rule, error) {
 	return &r.Rule, err
 }
 
+// ListOpts allows to filter the private SNAT rule list using given parameters.
+type ListOpts struct {
+	// The ID of the private SNAT rule.
+	ID string `q:"id"`
+	// The ID of the gateway to which the private SNAT rule belongs.
+	GatewayId string `q:"gateway_id"`
+	// The CIDR block of the match rule.
+	Cidr string `q:"cidr"`
+	// The subnet ID of the match rule.
+	SubnetId string `q:"virsubnet_id"`
+	// The ID of the transit IP associated with the private SNAT rule.
+	TransitIpId string `q:"transit_ip_id"`
+	// The address of the transit IP associated with the private SNAT rule.
+	TransitIpAddress string `q:"transit_ip_address"`
+	// The description of the private SNAT rule.
+	Description string `q:"description"`
+	// The ID of the enterprise project to which the private SNAT rule belongs.
+	EnterpriseProjectId string `q:"enterprise_project_id"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last private SNAT rule on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all private SNAT rules using given parameters.
+func List(c *golangsdk.ServiceClient, opts ListOpts) ([]Rule, error) {
+	url := rootURL(c)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := RulePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return extractRules(pages)
+}
+
 // UpdateOpts is the structure used to modify an existing private SNAT rule.
 type UpdateOpts struct {
 	// The ID list of the transit IPs for private NAT.
@@ -1,5 +1,9 @@
 package snats
 
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
 // Rule is a struct that represents the private SNAT rule detail.
 type Rule struct {
 	// The ID of the SNAT rule.
@@ -54,3 +58,43 @@ type updateResp struct {
 	// The request ID.
 	RequestId string `json:"request_id"`
 }
+
+type listResp struct {
+	// The list of the SNAT rule details.
+	Rules []Rule `json:"snat_rules"`
+	// The page information.
+	PageInfo pageInfo `json:"page_info"`
+}
+
+// pageInfo is the structure that represents the page information.
+type pageInfo struct {
+	// The marker of the next page.
+	NextMarker string `json:"next_marker"`
+	// The number of the private SNAT rules in current page.
+	CurrentCount int `json:"current_count"`
+}
+
+// RulePage represents the response pages of the List method.
+type RulePage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if the page has no private SNAT rules.
+func (r RulePage) IsEmpty() (bool, error) {
+	resp, err := extractRules(r)
+	return len(resp) == 0, err
+}
+
+// LastMarker returns the marker of the next page, it is empty if the current page is the last one.
+func (r RulePage) LastMarker() (string, error) {
+	var s listResp
+	err := r.Result.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+// extractRules is a method which to extract the response to a private SNAT rule list.
+func extractRules(r pagination.Page) ([]Rule, error) {
+	var s listResp
+	err := r.(RulePage).Result.ExtractInto(&s)
+	return s.Rules, err
+}
@@ -3,6 +3,7 @@ package transitips
 import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
 )
 
 // CreateOpts is the structure used to create a new transit IP.
@@ -44,6 +45,48 @@ func Get(c *golangsdk.ServiceClient, transitIpId string) (*TransitIp, error) {
 	return &r.TransitIp, err
 }
 
+// ListOpts allows to filter the transit IP list using given parameters.
+type ListOpts struct {
+	// The ID of the transit IP.
+	ID string `q:"id"`
+	// The IP address of the transit IP.
+	IpAddress string `q:"ip_address"`
+	// The ID of the private NAT gateway to which the transit IP belongs.
+	GatewayId string `q:"gateway_id"`
+	// The network interface ID of the transit IP.
+	NetworkInterfaceId string `q:"network_interface_id"`
+	// The ID of the subnet to which the transit IP belongs.
+	SubnetId string `q:"virsubnet_id"`
+	// The ID of the enterprise project to which the transit IP belongs.
+	EnterpriseProjectId string `q:"enterprise_project_id"`
+	// The number of records displayed on each page.
+	Limit int `q:"limit"`
+	// The ID of the last transit IP on the previous page, the query starts from the next record of it.
+	Marker string `q:"marker"`
+}
+
+// List is a method to query all transit IPs using given parameters.
+func List(c *golangsdk.ServiceClient, opts ListOpts) ([]TransitIp, error) {
+	url := rootURL(c)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		p := TransitIpPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return extractTransitIps(pages)
+}
+
 // Delete is a method to remove the specified transit IP using its ID.
 func Delete(c *golangsdk.ServiceClient, transitIpId string) error {
 	_, err := c.Delete(resourceURL(c, transitIpId), &golangsdk.RequestOpts{
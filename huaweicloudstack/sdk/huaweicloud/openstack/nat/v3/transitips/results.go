@@ -1,6 +1,9 @@
 package transitips
 
-import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
 
 // TransitIp is the structure that represents the detail of the transit IP for private NAT.
 type TransitIp struct {
@@ -39,3 +42,43 @@ type queryResp struct {
 	// Request ID.
 	RequestId string `json:"request_id"`
 }
+
+type listResp struct {
+	// The list of the transit IP details.
+	TransitIps []TransitIp `json:"transit_ips"`
+	// The page information.
+	PageInfo pageInfo `json:"page_info"`
+}
+
+// pageInfo is the structure that represents the page information.
+type pageInfo struct {
+	// The marker of the next page.
+	NextMarker string `json:"next_marker"`
+	// The number of the transit IPs in current page.
+	CurrentCount int `json:"current_count"`
+}
+
+// TransitIpPage represents the response pages of the List method.
+type TransitIpPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if the page has no transit IPs.
+func (r TransitIpPage) IsEmpty() (bool, error) {
+	resp, err := extractTransitIps(r)
+	return len(resp) == 0, err
+}
+
+// LastMarker returns the marker of the next page, it is empty if the current page is the last one.
+func (r TransitIpPage) LastMarker() (string, error) {
+	var s listResp
+	err := r.Result.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+// extractTransitIps is a method which to extract the response to a transit IP list.
+func extractTransitIps(r pagination.Page) ([]TransitIp, error) {
+	var s listResp
+	err := r.(TransitIpPage).Result.ExtractInto(&s)
+	return s.TransitIps, err
+}
@@ -99,6 +99,10 @@ type ProviderClient struct {
 	// MaxBackoffRetries set the maximum number of backoffs. When not set, defaults to DefaultMaxBackoffRetries
 	MaxBackoffRetries uint
 
+	// RetryableFunc reports whether the unexpected response should be retried with RetryBackoffFunc.
+	// When not set, only the StatusTooManyRequests (429) response is retried.
+	RetryableFunc func(*ErrUnexpectedResponseCode) bool
+
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
 	// and setting the TokenID.
 	mut *sync.RWMutex
@@ -432,7 +436,23 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 			if error429er, ok := errType.(Err429er); ok {
 				err = error429er.Error429(respErr)
 			}
+		case http.StatusInternalServerError:
+			err = ErrDefault500{respErr}
+			if error500er, ok := errType.(Err500er); ok {
+				err = error500er.Error500(respErr)
+			}
+		case http.StatusServiceUnavailable:
+			err = ErrDefault503{respErr}
+			if error503er, ok := errType.(Err503er); ok {
+				err = error503er.Error503(respErr)
+			}
+		}
 
+		if err == nil {
+			err = respErr
+		}
+
+		if client.isRetryable(&respErr) {
 			maxTries := client.MaxBackoffRetries
 			if maxTries == 0 {
 				maxTries = DefaultMaxBackoffRetries
@@ -446,23 +466,14 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 					return resp, e
 				}
 
+				if options.RawBody != nil {
+					if seeker, ok := options.RawBody.(io.Seeker); ok {
+						seeker.Seek(0, 0)
+					}
+				}
 				state.retries = state.retries + 1
 				return client.doRequest(method, url, options, state)
 			}
-		case http.StatusInternalServerError:
-			err = ErrDefault500{respErr}
-			if error500er, ok := errType.(Err500er); ok {
-				err = error500er.Error500(respErr)
-			}
-		case http.StatusServiceUnavailable:
-			err = ErrDefault503{respErr}
-			if error503er, ok := errType.(Err503er); ok {
-				err = error503er.Error503(respErr)
-			}
-		}
-
-		if err == nil {
-			err = respErr
 		}
 
 		return resp, err
@@ -494,6 +505,13 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	return resp, nil
 }
 
+func (client *ProviderClient) isRetryable(respErr *ErrUnexpectedResponseCode) bool {
+	if client.RetryableFunc != nil {
+		return client.RetryableFunc(respErr)
+	}
+	return respErr.Actual == http.StatusTooManyRequests
+}
+
 func defaultOkCodes(method string) []int {
 	switch method {
 	case "GET", "HEAD":
@@ -11,10 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/recorder"
 )
 
 var (
 	HCS_SKIP_UNSUPPORTED_TEST = os.Getenv("HCS_SKIP_UNSUPPORTED_TEST")
+	// The mode of the HTTP recorder used by the tests which call RecordReplay, the valid values are record and replay
+	HCS_RECORDER_MODE = os.Getenv("HCS_RECORDER_MODE")
+	// Whether to run the tests against the in-memory mock server of the core services instead of a live environment
+	HCS_MOCK_SERVER = os.Getenv("HCS_MOCK_SERVER")
 
 	HCS_REGION_NAME                        = os.Getenv("HCS_REGION_NAME")
 	HCS_CLOUD                              = os.Getenv("HCS_CLOUD")
@@ -237,6 +242,8 @@ var TestAccProvider *schema.Provider
 
 func init() {
 	TestAccProvider = huaweicloudstack.Provider()
+	withRecorder(TestAccProvider)
+	withMockServer(TestAccProvider)
 
 	TestAccProviders = map[string]*schema.Provider{
 		"hcs": TestAccProvider,
@@ -306,6 +313,13 @@ func TestAccPreCheck(t *testing.T) {
 		t.Skip("This environment only runs deprecated tests")
 	}
 
+	// the settings of the replayed tests are restored from the recordings by RecordReplay
+	if recorder.Mode(HCS_RECORDER_MODE) == recorder.ModeReplay {
+		return
+	}
+	if HCS_MOCK_SERVER != "" {
+		preCheckMockServer()
+	}
 	preCheckRequiredEnvVars(t)
 }
 
@@ -45,6 +45,26 @@ func TestAccBmsInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
 				),
 			},
+			{
+				Config: testAccBmsInstance_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBmsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+					resource.TestCheckResourceAttr(resourceName, "power_action", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SHUTOFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_pass", "user_data", "period_unit", "period", "auto_renew",
+					"delete_eip_on_termination", "delete_disks_on_termination",
+				},
+			},
 		},
 	})
 }
@@ -157,3 +177,43 @@ resource "hcs_bms_instance" "test" {
 }
 `, testAccBmsInstance_base(rName), rName, acceptance.HCS_USER_ID, acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST, isAutoRenew)
 }
+
+func testAccBmsInstance_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_vpc_eip" "myeip" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name        = "%[2]s"
+    size        = 8
+    share_type  = "PER"
+  }
+}
+
+resource "hcs_bms_instance" "test" {
+  security_groups   = [hcs_networking_secgroup.test.id]
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  vpc_id            = hcs_vpc.test.id
+  flavor_id         = ""
+  key_pair          = ""
+  image_id          = "519ea918-1fea-4ebc-911a-593739b1a3bc" # CentOS 7.4 64bit for BareMetal
+
+  name                  = "%[2]s-update"
+  user_id               = "%[3]s"
+  enterprise_project_id = "%[4]s"
+  power_action          = "OFF"
+
+  nics {
+    subnet_id = hcs_vpc_subnet.test.id
+  }
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, testAccBmsInstance_base(rName), rName, acceptance.HCS_USER_ID, acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST)
+}
@@ -2,9 +2,12 @@ package ddm
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
@@ -36,10 +39,40 @@ func TestAccDdmInstanceReadStrategy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "read_weights.#", "2"),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testDdmInstanceReadStrategyImportState(rName),
+			},
 		},
 	})
 }
 
+// testDdmInstanceReadStrategyImportState appends the read weights to the import ID, they can not be queried from the API.
+func testDdmInstanceReadStrategyImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("the resource (%s) not found", name)
+		}
+
+		count, _ := strconv.Atoi(rs.Primary.Attributes["read_weights.#"])
+		var weights []string
+		for key, dbID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "read_weights.") || !strings.HasSuffix(key, ".db_id") {
+				continue
+			}
+			weightKey := strings.TrimSuffix(key, ".db_id") + ".weight"
+			weights = append(weights, fmt.Sprintf("%s:%s", dbID, rs.Primary.Attributes[weightKey]))
+		}
+		if len(weights) != count {
+			return "", fmt.Errorf("expected %d read weights, got %d", count, len(weights))
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, strings.Join(weights, ",")), nil
+	}
+}
+
 func testDdmInstanceReadStrategy_basic(name, schemaName string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,83 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataDNSRecordsets_basic(t *testing.T) {
+	var (
+		name = fmt.Sprintf("acpttest-recordset-%s.com.", acctest.RandString(5))
+
+		dataSourceName = "data.hcs_dns_recordsets.name_filter"
+		nameFilter     = acceptance.InitDataSourceCheck(dataSourceName)
+		typeFilter     = acceptance.InitDataSourceCheck("data.hcs_dns_recordsets.type_filter")
+		statusFilter   = acceptance.InitDataSourceCheck("data.hcs_dns_recordsets.status_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDNSRecordsets_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					nameFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_name_filter_useful", "true"),
+					typeFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_type_filter_useful", "true"),
+					statusFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_status_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataDNSRecordsets_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  recordset_id = split("/", hcs_dns_recordset.test.id)[1]
+}
+
+data "hcs_dns_recordsets" "name_filter" {
+  zone_id = hcs_dns_recordset.test.zone_id
+  name    = hcs_dns_recordset.test.name
+}
+
+output "is_name_filter_useful" {
+  value = contains(data.hcs_dns_recordsets.name_filter.recordsets[*].id, local.recordset_id) && alltrue(
+    [for v in data.hcs_dns_recordsets.name_filter.recordsets[*].name : v == hcs_dns_recordset.test.name]
+  )
+}
+
+data "hcs_dns_recordsets" "type_filter" {
+  zone_id = hcs_dns_recordset.test.zone_id
+  type    = "A"
+}
+
+output "is_type_filter_useful" {
+  value = contains(data.hcs_dns_recordsets.type_filter.recordsets[*].id, local.recordset_id) && alltrue(
+    [for v in data.hcs_dns_recordsets.type_filter.recordsets[*].type : v == "A"]
+  )
+}
+
+data "hcs_dns_recordsets" "status_filter" {
+  zone_id = hcs_dns_recordset.test.zone_id
+  status  = "ACTIVE"
+}
+
+output "is_status_filter_useful" {
+  value = contains(data.hcs_dns_recordsets.status_filter.recordsets[*].id, local.recordset_id) && alltrue(
+    [for v in data.hcs_dns_recordsets.status_filter.recordsets[*].status : v == "ACTIVE"]
+  )
+}
+`, testDNSRecordset_basic(name))
+}
@@ -0,0 +1,69 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataDNSZones_basic(t *testing.T) {
+	var (
+		name = fmt.Sprintf("acpttest-zone-%s.com.", acctest.RandString(5))
+
+		dataSourceName = "data.hcs_dns_zones.name_filter"
+		nameFilter     = acceptance.InitDataSourceCheck(dataSourceName)
+		statusFilter   = acceptance.InitDataSourceCheck("data.hcs_dns_zones.status_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDNSZones_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					nameFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zones.0.id",
+						"hcs_dns_zone.zone_1", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.zone_type", "private"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.description", "a zone"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.ttl", "300"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zones.0.routers.0.router_id",
+						"hcs_vpc.default", "id"),
+					statusFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_status_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataDNSZones_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_dns_zones" "name_filter" {
+  zone_type = "private"
+  name      = hcs_dns_zone.zone_1.name
+}
+
+data "hcs_dns_zones" "status_filter" {
+  zone_type = "private"
+  status    = "ACTIVE"
+
+  depends_on = [hcs_dns_zone.zone_1]
+}
+
+output "is_status_filter_useful" {
+  value = length(data.hcs_dns_zones.status_filter.zones) > 0 && alltrue(
+    [for v in data.hcs_dns_zones.status_filter.zones[*].status : v == "ACTIVE"]
+  )
+}
+`, testAccDNSZone_basic(name))
+}
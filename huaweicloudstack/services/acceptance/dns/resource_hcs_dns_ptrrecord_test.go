@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/ptrrecords"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getDNSPtrRecordResourceFunc(c *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	dnsClient, err := c.DnsV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS client: %s", err)
+	}
+	return ptrrecords.Get(dnsClient, state.Primary.ID).Extract()
+}
+
+func TestAccDNSPtrRecord_basic(t *testing.T) {
+	var ptr ptrrecords.Ptr
+	resourceName := "hcs_dns_ptrrecord.ptr_1"
+	name := fmt.Sprintf("acpttest-ptr-%s.com.", acctest.RandString(5))
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&ptr,
+		getDNSPtrRecordResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSPtrRecord_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "a ptr record"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "6000"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+					resource.TestCheckResourceAttrPair(resourceName, "floatingip_id", "hcs_vpc_eip.eip_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "address", "hcs_vpc_eip.eip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSPtrRecord_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("update-%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "description", "ptr record updated"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7000"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_update"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSPtrRecord_base(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_vpc_eip" "eip_1" {
+  publicip {
+    type = "%[2]s"
+  }
+
+  bandwidth {
+    name       = "%[1]s"
+    size       = 5
+    share_type = "PER"
+  }
+}
+`, acctest.RandomWithPrefix("tf-acc-ptr"), acceptance.HCS_EIP_EXTERNAL_NETWORK_NAME)
+}
+
+func testAccDNSPtrRecord_basic(ptrName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dns_ptrrecord" "ptr_1" {
+  name          = "%s"
+  description   = "a ptr record"
+  floatingip_id = hcs_vpc_eip.eip_1.id
+  ttl           = 6000
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, testAccDNSPtrRecord_base(ptrName), ptrName)
+}
+
+func testAccDNSPtrRecord_update(ptrName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dns_ptrrecord" "ptr_1" {
+  name          = "update-%s"
+  description   = "ptr record updated"
+  floatingip_id = hcs_vpc_eip.eip_1.id
+  ttl           = 7000
+
+  tags = {
+    foo = "bar_update"
+    key = "value_update"
+  }
+}
+`, testAccDNSPtrRecord_base(ptrName), ptrName)
+}
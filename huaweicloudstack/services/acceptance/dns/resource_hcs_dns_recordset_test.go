@@ -297,3 +297,104 @@ resource "hcs_dns_recordset" "test" {
 }
 `, testAccDNSZone_private(name), name)
 }
+
+func TestAccDNSRecordset_weighted(t *testing.T) {
+	var obj interface{}
+
+	name := fmt.Sprintf("acpttest-recordset-%s.com.", acctest.RandString(5))
+	rName := "hcs_dns_recordset.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDNSRecordsetResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDNSRecordset_weighted(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", fmt.Sprintf("www.%s", name)),
+					resource.TestCheckResourceAttr(rName, "line_id", "default_view"),
+					resource.TestCheckResourceAttr(rName, "weight", "10"),
+					resource.TestCheckResourceAttr(rName, "status", "ENABLE"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "value"),
+				),
+			},
+			{
+				Config: testDNSRecordset_weighted_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "line_id", "default_view"),
+					resource.TestCheckResourceAttr(rName, "weight", "0"),
+					resource.TestCheckResourceAttr(rName, "status", "DISABLE"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar_update"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "value_update"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDNSRecordset_publicZoneBase(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_dns_zone" "zone_1" {
+  name      = "%s"
+  zone_type = "public"
+}
+`, name)
+}
+
+func testDNSRecordset_weighted(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_dns_recordset" "test" {
+  zone_id = hcs_dns_zone.zone_1.id
+  name    = "www.%[2]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.10"]
+  line_id = "default_view"
+  weight  = 10
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, testDNSRecordset_publicZoneBase(name), name)
+}
+
+func testDNSRecordset_weighted_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_dns_recordset" "test" {
+  zone_id = hcs_dns_zone.zone_1.id
+  name    = "www.%[2]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.10"]
+  line_id = "default_view"
+  weight  = 0
+  status  = "DISABLE"
+
+  tags = {
+    foo = "bar_update"
+    key = "value_update"
+  }
+}
+`, testDNSRecordset_publicZoneBase(name), name)
+}
@@ -47,6 +47,8 @@ func TestAccDNSZone_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "a zone"),
 					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
 					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
 				),
 			},
 			{
@@ -59,6 +61,8 @@ func TestAccDNSZone_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "an updated zone"),
 					resource.TestCheckResourceAttr(resourceName, "ttl", "600"),
 					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_update"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
 				),
 			},
 		},
@@ -139,6 +143,11 @@ resource "hcs_dns_zone" "zone_1" {
   router {
     router_id = resource.hcs_vpc.default.id
   }
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
 }
 `, zoneName, zoneName)
 }
@@ -159,6 +168,11 @@ resource "hcs_dns_zone" "zone_1" {
   router {
     router_id = resource.hcs_vpc.default.id
   }
+
+  tags = {
+    foo = "bar_update"
+    key = "value_update"
+  }
 }
 `, zoneName, zoneName)
 }
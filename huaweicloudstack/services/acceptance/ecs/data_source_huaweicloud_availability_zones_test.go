@@ -9,6 +9,7 @@ import (
 )
 
 func TestAccAvailabilityZones_basic(t *testing.T) {
+	acceptance.RecordReplay(t)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
 		ProviderFactories: acceptance.TestAccProviderFactories,
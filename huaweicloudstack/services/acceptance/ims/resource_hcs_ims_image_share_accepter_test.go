@@ -83,6 +83,11 @@ func TestAccImsImageShareAccepter_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "image_id", acceptance.HCS_IMAGE_SHARE_SOURCE_IMAGE_ID),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
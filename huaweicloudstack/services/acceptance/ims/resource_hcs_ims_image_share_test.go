@@ -100,6 +100,11 @@ func TestAccImsImageShare_basic(t *testing.T) {
 						"hcs_ims_image.test", "id"),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,64 @@
+package acceptance
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/mockserver"
+)
+
+const mockRegionName = "mock-region-1"
+
+var (
+	mockServerOnce sync.Once
+	mockServer     *mockserver.Server
+)
+
+// preCheckMockServer starts the mock server and sets the credentials of the provider which are accepted by it,
+// the endpoints and auth_url of the provider are pointed at the server by withMockServer.
+func preCheckMockServer() {
+	mockServerOnce.Do(func() {
+		mockServer = mockserver.Start()
+
+		settings := []struct {
+			name  string
+			value string
+			field *string
+		}{
+			{"HCS_REGION_NAME", mockRegionName, &HCS_REGION_NAME},
+			{"HCS_PROJECT_NAME", mockRegionName, &HCS_PROJECT_NAME},
+			{"HCS_DOMAIN_NAME", mockserver.DomainName, &HCS_DOMAIN_NAME},
+			{"HCS_ACCESS_KEY", "mock-access-key", &HCS_ACCESS_KEY},
+			{"HCS_SECRET_KEY", "mock-secret-key", &HCS_SECRET_KEY},
+		}
+		for _, v := range settings {
+			os.Setenv(v.name, v.value)
+			*v.field = v.value
+		}
+		// the IDs are queried from the server
+		for _, name := range []string{"HCS_PROJECT_ID", "HCS_DOMAIN_ID", "HCS_AUTH_TOKEN", "HCS_SECURITY_TOKEN"} {
+			os.Unsetenv(name)
+		}
+		HCS_PROJECT_ID, HCS_DOMAIN_ID = "", ""
+	})
+}
+
+// withMockServer points the endpoints and auth_url of the provider at the mock server when it is started.
+func withMockServer(provider *schema.Provider) {
+	configure := provider.ConfigureContextFunc
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if mockServer != nil {
+			if err := d.Set("auth_url", mockServer.AuthURL()); err != nil {
+				return nil, diag.Errorf("error setting the auth_url of the mock server: %s", err)
+			}
+			if err := d.Set("endpoints", mockServer.Endpoints()); err != nil {
+				return nil, diag.Errorf("error setting the endpoints of the mock server: %s", err)
+			}
+		}
+		return configure(ctx, d)
+	}
+}
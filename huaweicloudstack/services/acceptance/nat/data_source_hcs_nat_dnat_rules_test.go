@@ -0,0 +1,92 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataPublicDnatRules_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName = "data.hcs_nat_dnat_rules.gateway_filter"
+		gatewayFilter  = acceptance.InitDataSourceCheck(dataSourceName)
+		portFilter     = acceptance.InitDataSourceCheck("data.hcs_nat_dnat_rules.port_filter")
+		eipFilter      = acceptance.InitDataSourceCheck("data.hcs_nat_dnat_rules.eip_filter")
+		protocolFilter = acceptance.InitDataSourceCheck("data.hcs_nat_dnat_rules.protocol_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPublicDnatRules_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					gatewayFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id",
+						"hcs_nat_dnat_rule.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.port_id",
+						"hcs_ecs_compute_instance.test", "network.0.port"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.protocol", "udp"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.internal_service_port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.external_service_port", "8080"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rules.0.floating_ip_address"),
+					portFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_port_filter_useful", "true"),
+					eipFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_eip_filter_useful", "true"),
+					protocolFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_protocol_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPublicDnatRules_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_dnat_rules" "gateway_filter" {
+  nat_gateway_id = hcs_nat_dnat_rule.test.nat_gateway_id
+}
+
+data "hcs_nat_dnat_rules" "port_filter" {
+  port_id = hcs_nat_dnat_rule.test.port_id
+}
+
+output "is_port_filter_useful" {
+  value = length(data.hcs_nat_dnat_rules.port_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_dnat_rules.port_filter.rules[*].port_id : v == hcs_nat_dnat_rule.test.port_id]
+  )
+}
+
+data "hcs_nat_dnat_rules" "eip_filter" {
+  floating_ip_address   = hcs_nat_dnat_rule.test.floating_ip_address
+  external_service_port = hcs_nat_dnat_rule.test.external_service_port
+}
+
+output "is_eip_filter_useful" {
+  value = length(data.hcs_nat_dnat_rules.eip_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_dnat_rules.eip_filter.rules[*].floating_ip_id : v == hcs_vpc_eip.test.id]
+  )
+}
+
+data "hcs_nat_dnat_rules" "protocol_filter" {
+  nat_gateway_id = hcs_nat_dnat_rule.test.nat_gateway_id
+  protocol       = "udp"
+}
+
+output "is_protocol_filter_useful" {
+  value = length(data.hcs_nat_dnat_rules.protocol_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_dnat_rules.protocol_filter.rules[*].protocol : v == "udp"]
+  )
+}
+`, testAccPublicDnatRule_basic_step_1(name))
+}
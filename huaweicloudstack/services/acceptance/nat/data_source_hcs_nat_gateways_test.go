@@ -0,0 +1,100 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func TestAccDataPublicGateways_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceName()
+
+		dataSourceName = "data.hcs_nat_gateways.name_filter"
+		nameFilter     = acceptance.InitDataSourceCheck(dataSourceName)
+		idFilter       = acceptance.InitDataSourceCheck("data.hcs_nat_gateways.id_filter")
+		vpcFilter      = acceptance.InitDataSourceCheck("data.hcs_nat_gateways.vpc_filter")
+		tagsFilter     = acceptance.InitDataSourceCheck("data.hcs_nat_gateways.tags_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPublicGateways_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					nameFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gateways.0.id",
+						"hcs_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.spec", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "gateways.0.created_at"),
+					idFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_id_filter_useful", "true"),
+					vpcFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_vpc_filter_useful", "true"),
+					tagsFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_tags_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPublicGateways_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_gateway" "test" {
+  name      = "%[2]s"
+  spec      = "1"
+  subnet_id = hcs_vpc_subnet.test.id
+  vpc_id    = hcs_vpc.test.id
+
+  tags = {
+    foo = "bar"
+  }
+}
+
+data "hcs_nat_gateways" "name_filter" {
+  name = hcs_nat_gateway.test.name
+}
+
+data "hcs_nat_gateways" "id_filter" {
+  gateway_id = hcs_nat_gateway.test.id
+}
+
+output "is_id_filter_useful" {
+  value = length(data.hcs_nat_gateways.id_filter.gateways) == 1 && alltrue(
+    [for v in data.hcs_nat_gateways.id_filter.gateways[*].id : v == hcs_nat_gateway.test.id]
+  )
+}
+
+data "hcs_nat_gateways" "vpc_filter" {
+  vpc_id = hcs_nat_gateway.test.vpc_id
+}
+
+output "is_vpc_filter_useful" {
+  value = length(data.hcs_nat_gateways.vpc_filter.gateways) > 0 && alltrue(
+    [for v in data.hcs_nat_gateways.vpc_filter.gateways[*].vpc_id : v == hcs_vpc.test.id]
+  )
+}
+
+data "hcs_nat_gateways" "tags_filter" {
+  tags = hcs_nat_gateway.test.tags
+}
+
+output "is_tags_filter_useful" {
+  value = length(data.hcs_nat_gateways.tags_filter.gateways) > 0 && alltrue(
+    [for v in data.hcs_nat_gateways.tags_filter.gateways[*].tags.foo : v == "bar"]
+  )
+}
+`, common.TestBaseNetwork(name), name)
+}
@@ -0,0 +1,79 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataPrivateDnatRules_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName  = "data.hcs_nat_private_dnat_rules.gateway_filter"
+		gatewayFilter   = acceptance.InitDataSourceCheck(dataSourceName)
+		protocolFilter  = acceptance.InitDataSourceCheck("data.hcs_nat_private_dnat_rules.protocol_filter")
+		privateIpFilter = acceptance.InitDataSourceCheck("data.hcs_nat_private_dnat_rules.private_ip_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPrivateDnatRules_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					gatewayFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id",
+						"hcs_nat_private_dnat_rule.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.transit_ip_id",
+						"hcs_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.backend_private_ip", "192.168.0.10"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.internal_service_port", "22"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.transit_service_port", "2222"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.description", "Created by acc test"),
+					protocolFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_protocol_filter_useful", "true"),
+					privateIpFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_private_ip_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPrivateDnatRules_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_private_dnat_rules" "gateway_filter" {
+  gateway_id = hcs_nat_private_dnat_rule.test.gateway_id
+}
+
+data "hcs_nat_private_dnat_rules" "protocol_filter" {
+  gateway_id = hcs_nat_private_dnat_rule.test.gateway_id
+  protocol   = hcs_nat_private_dnat_rule.test.protocol
+}
+
+output "is_protocol_filter_useful" {
+  value = length(data.hcs_nat_private_dnat_rules.protocol_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_private_dnat_rules.protocol_filter.rules[*].protocol : v == "tcp"]
+  )
+}
+
+data "hcs_nat_private_dnat_rules" "private_ip_filter" {
+  backend_private_ip = hcs_nat_private_dnat_rule.test.backend_private_ip
+}
+
+output "is_private_ip_filter_useful" {
+  value = length(data.hcs_nat_private_dnat_rules.private_ip_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_private_dnat_rules.private_ip_filter.rules[*].backend_private_ip : v == "192.168.0.10"]
+  )
+}
+`, testAccPrivateDnatRule_basic_step_1(name))
+}
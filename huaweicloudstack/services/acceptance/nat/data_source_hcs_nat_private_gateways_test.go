@@ -0,0 +1,94 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func TestAccDataPrivateGateways_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName = "data.hcs_nat_private_gateways.id_filter"
+		idFilter       = acceptance.InitDataSourceCheck(dataSourceName)
+		nameFilter     = acceptance.InitDataSourceCheck("data.hcs_nat_private_gateways.name_filter")
+		subnetFilter   = acceptance.InitDataSourceCheck("data.hcs_nat_private_gateways.subnet_filter")
+		tagsFilter     = acceptance.InitDataSourceCheck("data.hcs_nat_private_gateways.tags_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPrivateGateways_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					idFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gateways.0.id",
+						"hcs_nat_private_gateway.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.spec", "Small"),
+					resource.TestCheckResourceAttr(dataSourceName, "gateways.0.description", "Created by acc test"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gateways.0.subnet_id",
+						"hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gateways.0.vpc_id",
+						"hcs_vpc.test", "id"),
+					nameFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_name_filter_useful", "true"),
+					subnetFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_subnet_filter_useful", "true"),
+					tagsFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_tags_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPrivateGateways_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_private_gateways" "id_filter" {
+  gateway_id = hcs_nat_private_gateway.test.id
+}
+
+data "hcs_nat_private_gateways" "name_filter" {
+  name = hcs_nat_private_gateway.test.name
+}
+
+output "is_name_filter_useful" {
+  value = length(data.hcs_nat_private_gateways.name_filter.gateways) > 0 && alltrue(
+    [for v in data.hcs_nat_private_gateways.name_filter.gateways[*].name : v == hcs_nat_private_gateway.test.name]
+  )
+}
+
+data "hcs_nat_private_gateways" "subnet_filter" {
+  subnet_id = hcs_nat_private_gateway.test.subnet_id
+}
+
+output "is_subnet_filter_useful" {
+  value = length(data.hcs_nat_private_gateways.subnet_filter.gateways) > 0 && alltrue(
+    [for v in data.hcs_nat_private_gateways.subnet_filter.gateways[*].subnet_id : v == hcs_vpc_subnet.test.id]
+  )
+}
+
+data "hcs_nat_private_gateways" "tags_filter" {
+  tags = {
+    foo = "bar"
+  }
+
+  depends_on = [hcs_nat_private_gateway.test]
+}
+
+output "is_tags_filter_useful" {
+  value = contains(data.hcs_nat_private_gateways.tags_filter.gateways[*].id, hcs_nat_private_gateway.test.id)
+}
+`, testAccPrivateGateway_basic_step_1(name, common.TestBaseNetwork(name)))
+}
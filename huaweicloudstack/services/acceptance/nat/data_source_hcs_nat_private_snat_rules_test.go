@@ -0,0 +1,77 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataPrivateSnatRules_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName  = "data.hcs_nat_private_snat_rules.gateway_filter"
+		gatewayFilter   = acceptance.InitDataSourceCheck(dataSourceName)
+		transitIpFilter = acceptance.InitDataSourceCheck("data.hcs_nat_private_snat_rules.transit_ip_filter")
+		subnetFilter    = acceptance.InitDataSourceCheck("data.hcs_nat_private_snat_rules.subnet_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPrivateSnatRules_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					gatewayFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id",
+						"hcs_nat_private_snat_rule.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.transit_ip_id",
+						"hcs_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.subnet_id",
+						"hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.description", "Created by acc test"),
+					transitIpFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_transit_ip_filter_useful", "true"),
+					subnetFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_subnet_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPrivateSnatRules_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_private_snat_rules" "gateway_filter" {
+  gateway_id = hcs_nat_private_snat_rule.test.gateway_id
+}
+
+data "hcs_nat_private_snat_rules" "transit_ip_filter" {
+  transit_ip_id = hcs_nat_private_snat_rule.test.transit_ip_id
+}
+
+output "is_transit_ip_filter_useful" {
+  value = length(data.hcs_nat_private_snat_rules.transit_ip_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_private_snat_rules.transit_ip_filter.rules[*].transit_ip_id :
+    v == hcs_nat_private_transit_ip.test[0].id]
+  )
+}
+
+data "hcs_nat_private_snat_rules" "subnet_filter" {
+  subnet_id = hcs_nat_private_snat_rule.test.subnet_id
+}
+
+output "is_subnet_filter_useful" {
+  value = length(data.hcs_nat_private_snat_rules.subnet_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_private_snat_rules.subnet_filter.rules[*].subnet_id : v == hcs_vpc_subnet.test.id]
+  )
+}
+`, testAccPrivateSnatRule_basic_step_1(name))
+}
@@ -0,0 +1,91 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataPrivateTransitIps_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName = "data.hcs_nat_private_transit_ips.id_filter"
+		idFilter       = acceptance.InitDataSourceCheck(dataSourceName)
+		ipFilter       = acceptance.InitDataSourceCheck("data.hcs_nat_private_transit_ips.ip_filter")
+		subnetFilter   = acceptance.InitDataSourceCheck("data.hcs_nat_private_transit_ips.subnet_filter")
+		tagsFilter     = acceptance.InitDataSourceCheck("data.hcs_nat_private_transit_ips.tags_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPrivateTransitIps_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					idFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_ips.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_ips.0.id",
+						"hcs_nat_private_transit_ip.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_ips.0.ip_address", "172.20.1.10"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_ips.0.subnet_id",
+						"hcs_vpc_subnet.transit", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "transit_ips.0.network_interface_id"),
+					ipFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_ip_filter_useful", "true"),
+					subnetFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_subnet_filter_useful", "true"),
+					tagsFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_tags_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPrivateTransitIps_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_private_transit_ips" "id_filter" {
+  transit_ip_id = hcs_nat_private_transit_ip.test.id
+}
+
+data "hcs_nat_private_transit_ips" "ip_filter" {
+  ip_address = hcs_nat_private_transit_ip.test.ip_address
+}
+
+output "is_ip_filter_useful" {
+  value = length(data.hcs_nat_private_transit_ips.ip_filter.transit_ips) > 0 && alltrue(
+    [for v in data.hcs_nat_private_transit_ips.ip_filter.transit_ips[*].ip_address :
+    v == hcs_nat_private_transit_ip.test.ip_address]
+  )
+}
+
+data "hcs_nat_private_transit_ips" "subnet_filter" {
+  subnet_id = hcs_nat_private_transit_ip.test.subnet_id
+}
+
+output "is_subnet_filter_useful" {
+  value = length(data.hcs_nat_private_transit_ips.subnet_filter.transit_ips) > 0 && alltrue(
+    [for v in data.hcs_nat_private_transit_ips.subnet_filter.transit_ips[*].subnet_id : v == hcs_vpc_subnet.transit.id]
+  )
+}
+
+data "hcs_nat_private_transit_ips" "tags_filter" {
+  tags = {
+    foo = "bar"
+  }
+
+  depends_on = [hcs_nat_private_transit_ip.test]
+}
+
+output "is_tags_filter_useful" {
+  value = contains(data.hcs_nat_private_transit_ips.tags_filter.transit_ips[*].id, hcs_nat_private_transit_ip.test.id)
+}
+`, testAccPrivateTransitIp_basic_step_1(name))
+}
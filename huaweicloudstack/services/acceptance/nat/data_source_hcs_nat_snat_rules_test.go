@@ -0,0 +1,77 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataPublicSnatRules_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceNameWithDash()
+
+		dataSourceName = "data.hcs_nat_snat_rules.gateway_filter"
+		gatewayFilter  = acceptance.InitDataSourceCheck(dataSourceName)
+		eipFilter      = acceptance.InitDataSourceCheck("data.hcs_nat_snat_rules.eip_filter")
+		subnetFilter   = acceptance.InitDataSourceCheck("data.hcs_nat_snat_rules.subnet_filter")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPublicSnatRules_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					gatewayFilter.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id",
+						"hcs_nat_snat_rule.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.floating_ip_id",
+						"hcs_vpc_eip.test.0", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.subnet_id",
+						"hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.source_type", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.description", "Created by acc test"),
+					eipFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_eip_filter_useful", "true"),
+					subnetFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_subnet_filter_useful", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataPublicSnatRules_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_nat_snat_rules" "gateway_filter" {
+  nat_gateway_id = hcs_nat_snat_rule.test.nat_gateway_id
+}
+
+data "hcs_nat_snat_rules" "eip_filter" {
+  floating_ip_id = hcs_nat_snat_rule.test.floating_ip_id
+}
+
+output "is_eip_filter_useful" {
+  value = length(data.hcs_nat_snat_rules.eip_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_snat_rules.eip_filter.rules[*].floating_ip_id : v == hcs_vpc_eip.test[0].id]
+  )
+}
+
+data "hcs_nat_snat_rules" "subnet_filter" {
+  subnet_id = hcs_nat_snat_rule.test.subnet_id
+}
+
+output "is_subnet_filter_useful" {
+  value = length(data.hcs_nat_snat_rules.subnet_filter.rules) > 0 && alltrue(
+    [for v in data.hcs_nat_snat_rules.subnet_filter.rules[*].subnet_id : v == hcs_vpc_subnet.test.id]
+  )
+}
+`, testAccPublicSnatRule_basic_step_1(name))
+}
@@ -54,6 +54,10 @@ func TestAccPublicGateway_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "name", name),
 					resource.TestCheckResourceAttr(rName, "spec", string(nat.PublicSpecTypeSmall)),
 					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "value"),
+					resource.TestCheckResourceAttrSet(rName, "enterprise_project_id"),
 				),
 			},
 			{
@@ -63,6 +67,9 @@ func TestAccPublicGateway_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "name", updateName),
 					resource.TestCheckResourceAttr(rName, "spec", string(nat.PublicSpecTypeMedium)),
 					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "baar"),
+					resource.TestCheckResourceAttr(rName, "tags.newkey", "value"),
 				),
 			},
 			{
@@ -84,6 +91,11 @@ resource "hcs_nat_gateway" "test" {
   description           = "Created by acc test"
   vpc_id                = hcs_vpc.test.id
   subnet_id             = hcs_vpc_subnet.test.id
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
 }
 `, relatedConfig, name)
 }
@@ -97,6 +109,11 @@ resource "hcs_nat_gateway" "test" {
   spec                  = "2"
   vpc_id                = hcs_vpc.test.id
   subnet_id             = hcs_vpc_subnet.test.id
+
+  tags = {
+    foo    = "baar"
+    newkey = "value"
+  }
 }
 `, relatedConfig, name)
 }
@@ -0,0 +1,110 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/dnats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getPrivateDnatRuleResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NatV3Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	return dnats.Get(client, state.Primary.ID)
+}
+
+func TestAccPrivateDnatRule_basic(t *testing.T) {
+	var (
+		obj dnats.Rule
+
+		rName = "hcs_nat_private_dnat_rule.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateDnatRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateDnatRule_basic_step_1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "gateway_id", "hcs_nat_private_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "hcs_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttr(rName, "backend_private_ip", "192.168.0.10"),
+					resource.TestCheckResourceAttr(rName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(rName, "internal_service_port", "22"),
+					resource.TestCheckResourceAttr(rName, "transit_service_port", "2222"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttrSet(rName, "backend_type"),
+				),
+			},
+			{
+				Config: testAccPrivateDnatRule_basic_step_2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "hcs_nat_private_transit_ip.test.1", "id"),
+					resource.TestCheckResourceAttr(rName, "backend_private_ip", "192.168.0.20"),
+					resource.TestCheckResourceAttr(rName, "protocol", "udp"),
+					resource.TestCheckResourceAttr(rName, "internal_service_port", "53"),
+					resource.TestCheckResourceAttr(rName, "transit_service_port", "5353"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPrivateDnatRule_basic_step_1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_dnat_rule" "test" {
+  gateway_id            = hcs_nat_private_gateway.test.id
+  transit_ip_id         = hcs_nat_private_transit_ip.test[0].id
+  backend_private_ip    = "192.168.0.10"
+  protocol              = "tcp"
+  internal_service_port = 22
+  transit_service_port  = 2222
+  description           = "Created by acc test"
+}
+`, testAccPrivateNat_base(name))
+}
+
+func testAccPrivateDnatRule_basic_step_2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_dnat_rule" "test" {
+  gateway_id            = hcs_nat_private_gateway.test.id
+  transit_ip_id         = hcs_nat_private_transit_ip.test[1].id
+  backend_private_ip    = "192.168.0.20"
+  protocol              = "udp"
+  internal_service_port = 53
+  transit_service_port  = 5353
+}
+`, testAccPrivateNat_base(name))
+}
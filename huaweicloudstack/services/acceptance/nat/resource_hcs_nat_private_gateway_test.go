@@ -0,0 +1,120 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/gateways"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/nat"
+)
+
+func getPrivateGatewayResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NatV3Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	return gateways.Get(client, state.Primary.ID)
+}
+
+func TestAccPrivateGateway_basic(t *testing.T) {
+	var (
+		obj gateways.Gateway
+
+		rName         = "hcs_nat_private_gateway.test"
+		name          = acceptance.RandomAccResourceNameWithDash()
+		updateName    = acceptance.RandomAccResourceNameWithDash()
+		relatedConfig = common.TestBaseNetwork(name)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateGatewayResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateGateway_basic_step_1(name, relatedConfig),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "spec", string(nat.PrivateSpecTypeSmall)),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "vpc_id", "hcs_vpc.test", "id"),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "value"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(rName, "enterprise_project_id"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccPrivateGateway_basic_step_2(updateName, relatedConfig),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "spec", string(nat.PrivateSpecTypeMedium)),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "baar"),
+					resource.TestCheckResourceAttr(rName, "tags.newkey", "value"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPrivateGateway_basic_step_1(name, relatedConfig string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_gateway" "test" {
+  subnet_id   = hcs_vpc_subnet.test.id
+  name        = "%[2]s"
+  description = "Created by acc test"
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, relatedConfig, name)
+}
+
+func testAccPrivateGateway_basic_step_2(name, relatedConfig string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_gateway" "test" {
+  subnet_id = hcs_vpc_subnet.test.id
+  name      = "%[2]s"
+  spec      = "Medium"
+
+  tags = {
+    foo    = "baar"
+    newkey = "value"
+  }
+}
+`, relatedConfig, name)
+}
@@ -0,0 +1,119 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/snats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func getPrivateSnatRuleResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NatV3Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	return snats.Get(client, state.Primary.ID)
+}
+
+func TestAccPrivateSnatRule_basic(t *testing.T) {
+	var (
+		obj snats.Rule
+
+		rName = "hcs_nat_private_snat_rule.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateSnatRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateSnatRule_basic_step_1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "gateway_id", "hcs_nat_private_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "hcs_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_address",
+						"hcs_nat_private_transit_ip.test.0", "ip_address"),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+				),
+			},
+			{
+				Config: testAccPrivateSnatRule_basic_step_2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "hcs_nat_private_transit_ip.test.1", "id"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccPrivateNat_base returns the private NAT gateway in the base network and two transit IPs.
+func testAccPrivateNat_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "hcs_nat_private_gateway" "test" {
+  subnet_id = hcs_vpc_subnet.test.id
+  name      = "%[3]s"
+}
+
+resource "hcs_nat_private_transit_ip" "test" {
+  count = 2
+
+  subnet_id = hcs_vpc_subnet.transit.id
+}
+`, common.TestBaseNetwork(name), testAccPrivateTransitIp_base(name), name)
+}
+
+func testAccPrivateSnatRule_basic_step_1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_snat_rule" "test" {
+  gateway_id    = hcs_nat_private_gateway.test.id
+  transit_ip_id = hcs_nat_private_transit_ip.test[0].id
+  subnet_id     = hcs_vpc_subnet.test.id
+  description   = "Created by acc test"
+}
+`, testAccPrivateNat_base(name))
+}
+
+func testAccPrivateSnatRule_basic_step_2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_snat_rule" "test" {
+  gateway_id    = hcs_nat_private_gateway.test.id
+  transit_ip_id = hcs_nat_private_transit_ip.test[1].id
+  subnet_id     = hcs_vpc_subnet.test.id
+}
+`, testAccPrivateNat_base(name))
+}
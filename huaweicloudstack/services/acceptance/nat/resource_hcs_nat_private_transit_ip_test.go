@@ -0,0 +1,124 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/transitips"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getPrivateTransitIpResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NatV3Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	return transitips.Get(client, state.Primary.ID)
+}
+
+func TestAccPrivateTransitIp_basic(t *testing.T) {
+	var (
+		obj transitips.TransitIp
+
+		rName = "hcs_nat_private_transit_ip.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateTransitIpResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateTransitIp_basic_step_1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "hcs_vpc_subnet.transit", "id"),
+					resource.TestCheckResourceAttr(rName, "ip_address", "172.20.1.10"),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "value"),
+					resource.TestCheckResourceAttrSet(rName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccPrivateTransitIp_basic_step_2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "baar"),
+					resource.TestCheckResourceAttr(rName, "tags.newkey", "value"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccPrivateTransitIp_base returns the transit VPC and subnet, the traffic is translated to the transit IPs.
+func testAccPrivateTransitIp_base(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_vpc" "transit" {
+  name = "%[1]s-transit"
+  cidr = "172.20.0.0/16"
+}
+
+resource "hcs_vpc_subnet" "transit" {
+  name       = "%[1]s-transit"
+  vpc_id     = hcs_vpc.transit.id
+  cidr       = "172.20.1.0/24"
+  gateway_ip = "172.20.1.1"
+}
+`, name)
+}
+
+func testAccPrivateTransitIp_basic_step_1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_transit_ip" "test" {
+  subnet_id  = hcs_vpc_subnet.transit.id
+  ip_address = "172.20.1.10"
+
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, testAccPrivateTransitIp_base(name))
+}
+
+func testAccPrivateTransitIp_basic_step_2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_nat_private_transit_ip" "test" {
+  subnet_id  = hcs_vpc_subnet.transit.id
+  ip_address = "172.20.1.10"
+
+  tags = {
+    foo    = "baar"
+    newkey = "value"
+  }
+}
+`, testAccPrivateTransitIp_base(name))
+}
@@ -0,0 +1,61 @@
+package quota
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataSourceQuotas_basic(t *testing.T) {
+	var (
+		all        = "data.hcs_quotas.all"
+		dc         = acceptance.InitDataSourceCheck(all)
+		filter     = "data.hcs_quotas.filter"
+		dcByFilter = acceptance.InitDataSourceCheck(filter)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceQuotas_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestMatchResourceAttr(all, "quotas.#", regexp.MustCompile(`^[1-9]([0-9]*)?$`)),
+					resource.TestCheckResourceAttrSet(all, "quotas.0.service"),
+					resource.TestCheckResourceAttrSet(all, "quotas.0.type"),
+					resource.TestCheckResourceAttrSet(all, "quotas.0.limit"),
+					resource.TestCheckResourceAttrSet(all, "quotas.0.used"),
+					resource.TestCheckResourceAttrSet(all, "quotas.0.remaining"),
+					dcByFilter.CheckResourceExists(),
+					resource.TestCheckOutput("is_service_filter_useful", "true"),
+					resource.TestCheckOutput("is_cores_found", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceQuotas_basic = `
+data "hcs_quotas" "all" {}
+
+data "hcs_quotas" "filter" {
+  services = ["ecs"]
+}
+
+output "is_service_filter_useful" {
+  value = length(data.hcs_quotas.filter.quotas) > 0 && alltrue(
+    [for v in data.hcs_quotas.filter.quotas[*].service : v == "ecs"]
+  )
+}
+
+output "is_cores_found" {
+  value = contains(data.hcs_quotas.filter.quotas[*].type, "cores")
+}
+`
@@ -2,9 +2,11 @@ package rds
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
 )
@@ -29,10 +31,36 @@ func TestAccPgDatabasePrivilege_basic(t *testing.T) {
 			{
 				Config: testAccRdsPgDatabasePrivilege_basic_update(name, password),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccPgDatabasePrivilegeImportState(resourceName),
+				ImportStateVerifyIgnore: []string{"enable_force_new"},
+			},
 		},
 	})
 }
 
+func testAccPgDatabasePrivilegeImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("the resource (%s) not found", name)
+		}
+
+		var users []string
+		for _, account := range []string{"hcs_rds_pg_account.member", "hcs_rds_pg_account.test"} {
+			accountRs, ok := s.RootModule().Resources[account]
+			if !ok {
+				return "", fmt.Errorf("the resource (%s) not found", account)
+			}
+			users = append(users, accountRs.Primary.Attributes["name"]+":public")
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, strings.Join(users, ",")), nil
+	}
+}
+
 func testAccRdsPgDatabasePrivilege_base(name, password string) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,129 @@
+package acceptance
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/recorder"
+)
+
+var (
+	currentRecorderLock sync.Mutex
+	currentRecorder     *recorder.Recorder
+)
+
+// recordedSettings are the settings of the provider which are saved to the cassettes and restored in replay mode,
+// the requests and responses contain them. The credentials are not saved.
+var recordedSettings = []struct {
+	name  string
+	field *string
+}{
+	{"HCS_REGION_NAME", &HCS_REGION_NAME},
+	{"HCS_CLOUD", &HCS_CLOUD},
+	{"HCS_PROJECT_NAME", &HCS_PROJECT_NAME},
+	{"HCS_PROJECT_ID", &HCS_PROJECT_ID},
+	{"HCS_DOMAIN_NAME", &HCS_DOMAIN_NAME},
+	{"HCS_DOMAIN_ID", &HCS_DOMAIN_ID},
+}
+
+// replaySettings are the settings which are required by the provider but never sent in the replayed requests.
+var replaySettings = map[string]string{
+	"HCS_AUTH_URL":   "https://iam-apigateway-proxy.replay.example.com/v3",
+	"HCS_ACCESS_KEY": "replay-access-key",
+	"HCS_SECRET_KEY": "replay-secret-key",
+}
+
+// RecordReplay makes the test record the API interactions to testdata/recordings/{test name}.json, or replay them
+// without a live HuaweiCloudStack, according to HCS_RECORDER_MODE. It must be called at the beginning of the test,
+// before the random names are generated, and the tests are recorded and replayed with -parallel 1.
+func RecordReplay(t *testing.T) {
+	mode := recorder.Mode(HCS_RECORDER_MODE)
+	if mode == recorder.ModeDisabled {
+		return
+	}
+
+	// the random names must be the same as the recording, so they are generated with a seed of the test name
+	seed := fnv.New64a()
+	seed.Write([]byte(t.Name()))
+	rand.Seed(int64(seed.Sum64()))
+
+	path := filepath.Join("testdata", "recordings", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	r, err := recorder.New(mode, path, config.MaskRecordedFields)
+	if err != nil {
+		t.Fatalf("error creating the HTTP recorder: %s", err)
+	}
+	if mode == recorder.ModeReplay {
+		restoreRecordedSettings(r)
+	} else {
+		for _, v := range recordedSettings {
+			if *v.field != "" {
+				r.SetVariable(v.name, *v.field)
+			}
+		}
+	}
+
+	currentRecorderLock.Lock()
+	currentRecorder = r
+	currentRecorderLock.Unlock()
+
+	t.Cleanup(func() {
+		currentRecorderLock.Lock()
+		currentRecorder = nil
+		currentRecorderLock.Unlock()
+
+		// the interactions of the failed tests are not saved, they can not be replayed either
+		if t.Failed() {
+			return
+		}
+		if err := r.Save(); err != nil {
+			t.Errorf("error saving the cassette %s: %s", path, err)
+		}
+	})
+}
+
+// restoreRecordedSettings sets the environment variables and the settings of the acceptance tests to the values of
+// the recording, so the test is replayed without the environment of a live HuaweiCloudStack. The os.Setenv is used
+// because t.Setenv can not be used in the parallel tests.
+func restoreRecordedSettings(r *recorder.Recorder) {
+	for _, v := range recordedSettings {
+		value, ok := r.Variable(v.name)
+		if ok {
+			os.Setenv(v.name, value)
+		} else {
+			os.Unsetenv(v.name)
+		}
+		*v.field = value
+	}
+	for name, value := range replaySettings {
+		os.Setenv(name, value)
+	}
+	HCS_ACCESS_KEY, HCS_SECRET_KEY = replaySettings["HCS_ACCESS_KEY"], replaySettings["HCS_SECRET_KEY"]
+	for _, name := range []string{"HCS_AUTH_TOKEN", "HCS_SECURITY_TOKEN", "HCS_USER_NAME", "HCS_USER_ID", "HCS_USER_PASSWORD"} {
+		os.Unsetenv(name)
+	}
+}
+
+// withRecorder passes the recorder of the running test to the ConfigureContextFunc of the provider.
+func withRecorder(provider *schema.Provider) {
+	configure := provider.ConfigureContextFunc
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		currentRecorderLock.Lock()
+		r := currentRecorder
+		currentRecorderLock.Unlock()
+
+		if r != nil {
+			ctx = config.ContextWithRecorder(ctx, r)
+		}
+		return configure(ctx, d)
+	}
+}
@@ -180,3 +180,52 @@ data "hcs_vpcs" "test" {
 }
 `, testAccDataSourceVpcs_base(rName, cidr))
 }
+
+func TestAccVpcsDataSource_byTags(t *testing.T) {
+	randName := acceptance.RandomAccResourceName()
+	randCidr := acceptance.RandomCidr()
+	dataSourceName := "data.hcs_vpcs.test"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVpcs_byTags(randName, randCidr),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.0.name", randName),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.0.tags.owner", randName),
+					acceptance.TestCheckResourceAttrWithVariable(dataSourceName, "vpcs.0.id",
+						"${hcs_vpc.test.id}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVpcs_byTags(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "hcs_vpc" "test" {
+  name = "%[1]s"
+  cidr = "%[2]s"
+
+  tags = {
+    owner = "%[1]s"
+  }
+}
+
+data "hcs_vpcs" "test" {
+  tags = {
+    owner = "%[1]s"
+  }
+
+  depends_on = [
+    hcs_vpc.test
+  ]
+}
+`, rName, cidr)
+}
@@ -45,6 +45,13 @@ func TestAccVpcSubnetV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdate),
 					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
 					resource.TestCheckResourceAttr(resourceName, "dhcp_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_lease_time", "48h"),
+					resource.TestCheckResourceAttr(resourceName, "ntp_server_address", "10.100.0.33,10.100.0.34"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_domain_name", "test.com"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
+					resource.TestCheckResourceAttrPair(resourceName, "enterprise_project_id",
+						"hcs_vpc.test", "enterprise_project_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "ipv4_subnet_id"),
 				),
 			},
@@ -143,6 +150,14 @@ resource "hcs_vpc_subnet" "test" {
   vpc_id            = hcs_vpc.test.id
   description       = "updated by acc test"
 
+  dhcp_lease_time    = "48h"
+  ntp_server_address = "10.100.0.33,10.100.0.34"
+  dhcp_domain_name   = "test.com"
+
+  tags = {
+    foo = "bar"
+    key = "value_update"
+  }
 }
 `, testAccVpcSubnet_base(rName), rName)
 }
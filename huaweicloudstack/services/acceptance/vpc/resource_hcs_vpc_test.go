@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccVpcV1_basic(t *testing.T) {
+	acceptance.RecordReplay(t)
+
 	var vpc vpcs.Vpc
 
 	rName := acceptance.RandomAccResourceName()
@@ -52,6 +54,45 @@ func TestAccVpcV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccVpcV1_tags(t *testing.T) {
+	var vpc vpcs.Vpc
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1_tags(rName, "created by acc test", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+				),
+			},
+			{
+				Config: testAccVpcV1_tags(rName, "", "value_update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVpcV1_secondaryCIDR(t *testing.T) {
 	var vpc vpcs.Vpc
 
@@ -255,6 +296,21 @@ resource "hcs_vpc" "test" {
 `, rName)
 }
 
+func testAccVpcV1_tags(rName, description, tagValue string) string {
+	return fmt.Sprintf(`
+resource "hcs_vpc" "test" {
+  name        = "%[1]s"
+  cidr        = "193.168.0.0/16"
+  description = "%[2]s"
+
+  tags = {
+    foo = "bar"
+    key = "%[3]s"
+  }
+}
+`, rName, description, tagValue)
+}
+
 func testAccVpcV1_secondaryCIDR(rName string) string {
 	return fmt.Sprintf(`
 resource "hcs_vpc" "test" {
@@ -38,6 +38,11 @@ func TestAccVPCEndpointApproval_Basic(t *testing.T) {
 				),
 				ExpectNonEmptyPlan: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccVPCEndpointApproval_Update(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -9,6 +9,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/autoscaling/v1/groups"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/autoscaling/v1/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -333,7 +334,9 @@ func dataSourceASGroupRead(_ context.Context, d *schema.ResourceData, meta inter
 		if resourceTags, err := tags.Get(asClient, groupID).Extract(); err == nil {
 			tagMap := make(map[string]string)
 			for _, val := range resourceTags.Tags {
-				tagMap[val.Key] = val.Value
+				if !utils.IsIgnoredTagKey(conf, val.Key) {
+					tagMap[val.Key] = val.Value
+				}
 			}
 			groupMap["tags"] = tagMap
 		} else {
@@ -44,6 +44,8 @@ func ResourceASGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -228,7 +230,8 @@ func ResourceASGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -544,7 +547,7 @@ func resourceASGroupCreate(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(asgId)
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		taglist := expandGroupsTags(tagRaw)
 		if tagErr := tags.Create(asClient, asgId, taglist).ExtractErr(); tagErr != nil {
@@ -627,9 +630,13 @@ func resourceASGroupRead(_ context.Context, d *schema.ResourceData, meta interfa
 	if resourceTags, err := tags.Get(asClient, groupID).Extract(); err == nil {
 		tagmap := make(map[string]string)
 		for _, val := range resourceTags.Tags {
-			tagmap[val.Key] = val.Value
+			if !utils.IsIgnoredTagKey(conf, val.Key) {
+				tagmap[val.Key] = val.Value
+			}
 		}
-		mErr = multierror.Append(mErr, d.Set("tags", tagmap))
+		mErr = multierror.Append(mErr,
+			utils.SetTagsAndTagsAll(d, conf, tagmap),
+		)
 	} else {
 		log.Printf("[WARN] Error fetching tags of AS group (%s): %s", groupID, err)
 	}
@@ -729,10 +736,9 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	// update tags
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		// remove old tags and set new tags
-		old, new := d.GetChange("tags")
-		oldRaw := old.(map[string]interface{})
+		oldRaw, newRaw := utils.GetResourceTagsChange(d, conf)
 		if len(oldRaw) > 0 {
 			taglist := expandGroupsTags(oldRaw)
 			if tagErr := tags.Delete(asClient, asgID, taglist).ExtractErr(); tagErr != nil {
@@ -740,7 +746,6 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		newRaw := new.(map[string]interface{})
 		if len(newRaw) > 0 {
 			taglist := expandGroupsTags(newRaw)
 			if tagErr := tags.Create(asClient, asgID, taglist).ExtractErr(); tagErr != nil {
@@ -2,10 +2,15 @@ package bms
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,13 +18,63 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/v2/volumes"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/bms/v1/baremetalservers"
-	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v2/ports"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/compute/v2/extensions/secgroups"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/evs/v2/cloudvolumes"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/eips"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/ports"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/eip"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/evs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/fmtp"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/logp"
 )
 
+var (
+	bmsInstanceNonUpdatableParams = []string{
+		"image_id",
+		"flavor_id",
+		"user_id",
+		"nics.0.subnet_id",
+		"nics.0.ip_address",
+		"availability_zone",
+		"vpc_id",
+		"user_data",
+		"key_pair",
+		"iptype",
+		"sharetype",
+		"bandwidth_size",
+		"enterprise_project_id",
+		"agency_name",
+	}
+
+	// If you want to support more actions, please update the validation of power_action simultaneously.
+	bmsPowerActionMap = map[string]string{
+		"ON":     "os-start",
+		"OFF":    "os-stop",
+		"REBOOT": "reboot",
+	}
+)
+
+// @API BMS POST /v1/{project_id}/baremetalservers
+// @API BMS GET /v1/{project_id}/jobs/{job_id}
+// @API BMS PUT /v1/{project_id}/baremetalservers/{server_id}
+// @API BMS PUT /v1/{project_id}/baremetalservers/{server_id}/os-reset-password
+// @API BMS POST /v1/{project_id}/baremetalservers/{server_id}/attachvolume
+// @API BMS DELETE /v1/{project_id}/baremetalservers/{server_id}/detachvolume/{volume_id}
+// @API BMS POST /v1/{project_id}/baremetalservers/{server_id}/nics
+// @API BMS POST /v1/{project_id}/baremetalservers/{server_id}/nics/delete
+// @API BMS POST /v1/{project_id}/baremetalservers/action
+// @API BMS POST /v1/{project_id}/baremetalservers/{server_id}/tags/action
+// @API BMS POST /v1/{project_id}/baremetalservers/delete
+// @API ECS GET /v1/{project_id}/cloudservers/{server_id}
+// @API ECS POST /v2.1/{project_id}/servers/{server_id}/action
+// @API EVS POST /v2/{project_id}/volumes
+// @API EVS GET /v2/{project_id}/cloudvolumes/{volume_id}
+// @API EVS DELETE /v2/{project_id}/volumes/{volume_id}
+// @API VPC GET /v1/{project_id}/publicips
+// @API VPC PUT /v1/{project_id}/publicips/{publicip_id}
 func ResourceBmsInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBmsInstanceCreate,
@@ -27,6 +82,17 @@ func ResourceBmsInstance() *schema.Resource {
 		UpdateContext: resourceBmsInstanceUpdate,
 		DeleteContext: resourceBmsInstanceDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBmsInstanceImportState,
+		},
+
+		CustomizeDiff: customdiff.All(
+			config.FlexibleForceNew(bmsInstanceNonUpdatableParams),
+			config.SetTagsAllDiff,
+			bmsInstanceDataDisksDiff,
+			bmsInstanceEIPDiff,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -47,35 +113,29 @@ func ResourceBmsInstance() *schema.Resource {
 			"image_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"flavor_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"user_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"nics": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 2,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"subnet_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"ip_address": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Computed: true,
 						},
 						"mac_address": {
@@ -92,24 +152,20 @@ func ResourceBmsInstance() *schema.Resource {
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				// just stash the hash for state & diff comparisons
 				StateFunc: utils.HashAndHexEncode,
 			},
 			"admin_pass": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 				ExactlyOneOf: []string{
 					"admin_pass", "key_pair",
@@ -118,7 +174,6 @@ func ResourceBmsInstance() *schema.Resource {
 			"key_pair": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				ExactlyOneOf: []string{
 					"admin_pass", "key_pair",
 				},
@@ -126,7 +181,6 @@ func ResourceBmsInstance() *schema.Resource {
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
@@ -134,7 +188,7 @@ func ResourceBmsInstance() *schema.Resource {
 			"eip_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				ConflictsWith: []string{
 					"iptype", "bandwidth_size", "sharetype",
 				},
@@ -142,7 +196,7 @@ func ResourceBmsInstance() *schema.Resource {
 			"iptype": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
+				Computed:      true,
 				ConflictsWith: []string{"eip_id"},
 				RequiredWith: []string{
 					"sharetype", "bandwidth_size",
@@ -151,7 +205,7 @@ func ResourceBmsInstance() *schema.Resource {
 			"sharetype": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"PER", "WHOLE",
 				}, true),
@@ -163,7 +217,7 @@ func ResourceBmsInstance() *schema.Resource {
 			"bandwidth_size": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ForceNew:      true,
+				Computed:      true,
 				ConflictsWith: []string{"eip_id"},
 				RequiredWith: []string{
 					"iptype", "sharetype",
@@ -172,19 +226,16 @@ func ResourceBmsInstance() *schema.Resource {
 			"data_disks": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 59,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"size": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -195,17 +246,24 @@ func ResourceBmsInstance() *schema.Resource {
 			"period":        common.SchemaPeriod([]string{}),
 			"auto_renew":    common.SchemaAutoRenewUpdatable(nil),
 
-			"tags": common.TagsForceNewSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"agency_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+			},
+			"power_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ON", "OFF", "REBOOT", "FORCE-OFF", "FORCE-REBOOT",
+				}, false),
 			},
 			"host_id": {
 				Type:     schema.TypeString,
@@ -242,10 +300,45 @@ func ResourceBmsInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"enable_force_new": config.EnableForceNewSchema(),
 		},
 	}
 }
 
+// bmsInstanceDataDisksDiff only allows appending the data disks to or removing them from the end of the list, the
+// type and size of the existing data disks can not be updated.
+func bmsInstanceDataDisksDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("data_disks") {
+		return nil
+	}
+
+	oRaw, nRaw := d.GetChange("data_disks")
+	oDisks, nDisks := oRaw.([]interface{}), nRaw.([]interface{})
+	for i := 0; i < len(oDisks) && i < len(nDisks); i++ {
+		oDisk, nDisk := oDisks[i].(map[string]interface{}), nDisks[i].(map[string]interface{})
+		if oDisk["type"] != nDisk["type"] || oDisk["size"] != nDisk["size"] {
+			return fmt.Errorf("the type and size of the data disk %d can not be updated, only appending or removing "+
+				"the data disks at the end of data_disks is supported", i)
+		}
+	}
+	return nil
+}
+
+// bmsInstanceEIPDiff plans to unbind the EIP when eip_id is removed from the configuration, eip_id is computed to save
+// the EIP created with iptype, so removing it does not make a diff by itself.
+func bmsInstanceEIPDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.Get("eip_id").(string) == "" {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.GetAttr("eip_id").IsNull() && rawConfig.GetAttr("iptype").IsNull() {
+		return d.SetNew("eip_id", "")
+	}
+	return nil
+}
+
 func resourceBmsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := config.GetHcsConfig(meta)
 	bmsClient, err := cfg.BmsV1Client(cfg.GetRegion(d))
@@ -294,7 +387,7 @@ func resourceBmsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		createOpts.PublicIp = &eipOpts
 	}
 
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		tagList := utils.ExpandResourceTagsString(tagRaw)
 		createOpts.Tags = tagList
@@ -318,6 +411,16 @@ func resourceBmsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		log.Printf("[INFO] BMS ID: %s", id)
 		// Store the ID now
 		d.SetId(id)
+		if err := d.Set("tags_all", tagRaw); err != nil {
+			return diag.Errorf("error saving tags_all of BMS server: %s", err)
+		}
+
+		// the server is running after created, so only the stop actions are done
+		if action := d.Get("power_action").(string); action == "OFF" || action == "FORCE-OFF" {
+			if err := doBmsPowerAction(bmsClient, d, action); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		return resourceBmsInstanceRead(ctx, d, meta)
 	}
 	return diag.Errorf("unexpected conversion error in resourceBmsInstanceCreate.")
@@ -355,6 +458,18 @@ func resourceBmsInstanceRead(_ context.Context, d *schema.ResourceData, meta int
 
 	logp.Printf("[DEBUG] Retrieved Server %s: %+v", d.Id(), server)
 
+	tagMap := utils.FlattenKeyValueTags(cfg, server.Tags)
+	mErr := multierror.Append(nil,
+		d.Set("charging_mode", normalizeChargingModeToString(server.Metadata.ChargingMode)),
+		d.Set("agency_name", server.Metadata.AgencyName),
+		utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		setBmsInstanceDataDisks(d, cfg, region, server.VolumeAttached),
+		setBmsInstanceEIP(d, cfg, region, bmsPublicIP(server)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("region", region)
 	d.Set("name", server.Name)
 	d.Set("image_id", server.Image.ID)
@@ -376,6 +491,7 @@ func resourceBmsInstanceRead(_ context.Context, d *schema.ResourceData, meta int
 	}
 	d.Set("security_groups", secGrpIds)
 	d.Set("status", server.Status)
+	setBmsInstancePowerAction(d, server.Status)
 	d.Set("user_id", server.Metadata.OpSvcUserId)
 	d.Set("image_name", server.Metadata.ImageName)
 	d.Set("vpc_id", server.Metadata.VpcID)
@@ -392,6 +508,118 @@ func resourceBmsInstanceRead(_ context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
+func resourceBmsInstanceImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	// the flags used by deletion can not be queried, so the default values are saved
+	mErr := multierror.Append(nil,
+		d.Set("delete_eip_on_termination", true),
+		d.Set("delete_disks_on_termination", false),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
+
+// setBmsInstancePowerAction saves the power action according to the status, the reboot and force actions in the
+// state are kept if they are consistent with the status.
+func setBmsInstancePowerAction(d *schema.ResourceData, status string) {
+	action := d.Get("power_action").(string)
+	switch status {
+	case "ACTIVE":
+		if action != "REBOOT" && action != "FORCE-REBOOT" {
+			d.Set("power_action", "ON")
+		}
+	case "SHUTOFF":
+		if action != "FORCE-OFF" {
+			d.Set("power_action", "OFF")
+		}
+	}
+}
+
+func normalizeChargingModeToString(mode string) string {
+	if mode == "1" {
+		return "prePaid"
+	}
+	return "postPaid"
+}
+
+// bmsInstanceDataVolumes returns the attached volumes except the system disk, they are sorted by the natural order of
+// the device names (/dev/sdb, ..., /dev/sdz, /dev/sdaa), which is the order they are attached in.
+func bmsInstanceDataVolumes(volumes []baremetalservers.VolumeAttached) []baremetalservers.VolumeAttached {
+	var dataVolumes []baremetalservers.VolumeAttached
+	for _, v := range volumes {
+		if v.BootIndex != "0" {
+			dataVolumes = append(dataVolumes, v)
+		}
+	}
+	sort.Slice(dataVolumes, func(i, j int) bool {
+		iDevice, jDevice := dataVolumes[i].Device, dataVolumes[j].Device
+		if len(iDevice) != len(jDevice) {
+			return len(iDevice) < len(jDevice)
+		}
+		return iDevice < jDevice
+	})
+	return dataVolumes
+}
+
+// setBmsInstanceDataDisks queries the type and size of the attached volumes except the system disk.
+func setBmsInstanceDataDisks(d *schema.ResourceData, cfg *config.HcsConfig, region string,
+	volumes []baremetalservers.VolumeAttached) error {
+	dataVolumes := bmsInstanceDataVolumes(volumes)
+	if len(dataVolumes) == 0 {
+		return d.Set("data_disks", nil)
+	}
+
+	client, err := cfg.BlockStorageV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+	dataDisks := make([]map[string]interface{}, 0, len(dataVolumes))
+	for _, v := range dataVolumes {
+		volume, err := cloudvolumes.Get(client, v.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving the data disk (%s) of BMS instance: %s", v.ID, err)
+		}
+		dataDisks = append(dataDisks, map[string]interface{}{
+			"type": volume.VolumeType,
+			"size": volume.Size,
+		})
+	}
+	return d.Set("data_disks", dataDisks)
+}
+
+// setBmsInstanceEIP saves both the ID and the specification of the EIP bound to the instance, so the configurations
+// using an existing EIP or creating a new one are both consistent with the state.
+func setBmsInstanceEIP(d *schema.ResourceData, cfg *config.HcsConfig, region, publicIP string) error {
+	if publicIP == "" {
+		return d.Set("eip_id", nil)
+	}
+
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating VPC client: %s", err)
+	}
+	pages, err := eips.List(client, eips.ListOpts{PublicIp: []string{publicIP}}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error querying the EIP (%s) of BMS instance: %s", publicIP, err)
+	}
+	publicIPs, err := eips.ExtractPublicIPs(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting the EIP (%s) of BMS instance: %s", publicIP, err)
+	}
+	if len(publicIPs) == 0 {
+		log.Printf("[WARN] the EIP (%s) of BMS instance (%s) is not found", publicIP, d.Id())
+		return nil
+	}
+
+	eip := publicIPs[0]
+	mErr := multierror.Append(nil,
+		d.Set("eip_id", eip.ID),
+		d.Set("iptype", eip.Type),
+		d.Set("sharetype", eip.BandwidthShareType),
+		d.Set("bandwidth_size", eip.BandwidthSize),
+	)
+	return mErr.ErrorOrNil()
+}
+
 func buildListOpts(d *schema.ResourceData, conf *config.HcsConfig) *baremetalservers.ListOpts {
 	result := &baremetalservers.ListOpts{
 		EnterpriseProjectID: conf.DataGetEnterpriseProjectID(d),
@@ -403,9 +631,350 @@ func buildListOpts(d *schema.ResourceData, conf *config.HcsConfig) *baremetalser
 }
 
 func resourceBmsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	bmsClient, err := cfg.BmsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BMS client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := baremetalservers.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if err := baremetalservers.Update(bmsClient, d.Id(), updateOpts).Err; err != nil {
+			return diag.Errorf("error updating the name of BMS instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("admin_pass") {
+		if newPwd := d.Get("admin_pass").(string); newPwd != "" {
+			if err := baremetalservers.ChangeAdminPassword(bmsClient, d.Id(), newPwd).ExtractErr(); err != nil {
+				return diag.Errorf("error changing admin password of BMS instance (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("security_groups") {
+		computeClient, err := cfg.ComputeV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating compute V2 client: %s", err)
+		}
+		if err := updateBmsInstanceSecGroups(d, computeClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var vpcClient *golangsdk.ServiceClient
+	var primaryPortId string
+	if d.HasChanges("nics", "eip_id") {
+		vpcClient, err = cfg.NetworkingV1Client(region)
+		if err != nil {
+			return diag.Errorf("error creating VPC client: %s", err)
+		}
+		primaryPortId, err = getBmsInstancePrimaryPortId(d, vpcClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("nics") {
+		if err := updateBmsInstanceNics(d, bmsClient, primaryPortId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("data_disks") {
+		if err := updateBmsInstanceDataDisks(ctx, d, cfg, bmsClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("eip_id") {
+		if err := updateBmsInstanceEIP(d, vpcClient, primaryPortId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		if err := utils.UpdateResourceTags(bmsClient, d, cfg, "baremetalservers", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of BMS instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	// The power status update needs to be done at the end
+	if d.HasChange("power_action") {
+		if err := doBmsPowerAction(bmsClient, d, d.Get("power_action").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceBmsInstanceRead(ctx, d, meta)
 }
 
+func updateBmsInstanceSecGroups(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oRaw, nRaw := d.GetChange("security_groups")
+	oSet, nSet := oRaw.(*schema.Set), nRaw.(*schema.Set)
+
+	for _, g := range oSet.Difference(nSet).List() {
+		err := secgroups.RemoveServer(client, d.Id(), g.(string)).ExtractErr()
+		if err != nil && err.Error() != "EOF" {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error removing security group (%s) from BMS instance (%s): %s", g, d.Id(), err)
+		}
+		log.Printf("[DEBUG] removed security group (%s) from BMS instance (%s)", g, d.Id())
+	}
+
+	for _, g := range nSet.Difference(oSet).List() {
+		err := secgroups.AddServer(client, d.Id(), g.(string)).ExtractErr()
+		if err != nil && err.Error() != "EOF" {
+			return fmt.Errorf("error adding security group (%s) to BMS instance (%s): %s", g, d.Id(), err)
+		}
+		log.Printf("[DEBUG] added security group (%s) to BMS instance (%s)", g, d.Id())
+	}
+	return nil
+}
+
+// getBmsInstancePrimaryPortId returns the port ID of the primary NIC, which is marked by the primary_interface of the
+// VIF details, rather than relying on the order of the NICs in the state.
+func getBmsInstancePrimaryPortId(d *schema.ResourceData, client *golangsdk.ServiceClient) (string, error) {
+	oRaw, _ := d.GetChange("nics")
+	for _, raw := range oRaw.([]interface{}) {
+		portId := raw.(map[string]interface{})["port_id"].(string)
+		if portId == "" {
+			continue
+		}
+		p, err := ports.Get(client, portId)
+		if err != nil {
+			return "", fmt.Errorf("error retrieving the port (%s) of BMS instance (%s): %s", portId, d.Id(), err)
+		}
+		if p.VifDetails.PrimaryInterface {
+			return portId, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find the primary NIC of BMS instance (%s)", d.Id())
+}
+
+// updateBmsInstanceNics replaces the extension NIC, the primary NIC can not be changed.
+func updateBmsInstanceNics(d *schema.ResourceData, client *golangsdk.ServiceClient, primaryPortId string) error {
+	oRaw, nRaw := d.GetChange("nics")
+	oNics, nNics := oRaw.([]interface{}), nRaw.([]interface{})
+	timeout := int(d.Timeout(schema.TimeoutUpdate) / time.Second)
+
+	var oNic map[string]interface{}
+	for _, raw := range oNics {
+		if nic := raw.(map[string]interface{}); nic["port_id"] != primaryPortId {
+			oNic = nic
+			break
+		}
+	}
+	if oNic != nil {
+		var nNic map[string]interface{}
+		if len(nNics) > 1 {
+			nNic = nNics[1].(map[string]interface{})
+		}
+		if nNic != nil && nNic["subnet_id"] == oNic["subnet_id"] &&
+			(nNic["ip_address"] == "" || nNic["ip_address"] == oNic["ip_address"]) {
+			return nil
+		}
+
+		deleteOpts := baremetalservers.DeleteNicsOpts{
+			Nics: []baremetalservers.DeleteNic{
+				{Id: oNic["port_id"].(string)},
+			},
+		}
+		job, err := baremetalservers.DeleteNics(client, d.Id(), deleteOpts).ExtractJobResponse()
+		if err != nil {
+			return fmt.Errorf("error deleting the NIC (%s) of BMS instance (%s): %s", oNic["port_id"], d.Id(), err)
+		}
+		if err := baremetalservers.WaitForJobSuccess(client, timeout, job.JobID); err != nil {
+			return fmt.Errorf("error waiting for the NIC (%s) of BMS instance (%s) to be deleted: %s",
+				oNic["port_id"], d.Id(), err)
+		}
+	}
+
+	if len(nNics) > 1 {
+		nNic := nNics[1].(map[string]interface{})
+		addOpts := baremetalservers.AddNicsOpts{
+			Nics: []baremetalservers.AddNic{
+				{
+					SubnetId:       nNic["subnet_id"].(string),
+					IpAddress:      nNic["ip_address"].(string),
+					SecurityGroups: resourceBmsInstanceSecGroupsV1(d),
+				},
+			},
+		}
+		job, err := baremetalservers.AddNics(client, d.Id(), addOpts).ExtractJobResponse()
+		if err != nil {
+			return fmt.Errorf("error adding the NIC to BMS instance (%s): %s", d.Id(), err)
+		}
+		if err := baremetalservers.WaitForJobSuccess(client, timeout, job.JobID); err != nil {
+			return fmt.Errorf("error waiting for the NIC to be added to BMS instance (%s): %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// updateBmsInstanceDataDisks detaches the removed data disks, which are also deleted if delete_disks_on_termination is
+// true, then creates and attaches the appended ones. The data disks are ordered by the device names, which is the
+// same order as they are read.
+func updateBmsInstanceDataDisks(ctx context.Context, d *schema.ResourceData, cfg *config.HcsConfig,
+	bmsClient *golangsdk.ServiceClient) error {
+	evsClient, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+
+	server, err := baremetalservers.GetBareMetalServer(bmsClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving BMS instance (%s): %s", d.Id(), err)
+	}
+	dataVolumes := bmsInstanceDataVolumes(server.VolumeAttached)
+
+	oRaw, nRaw := d.GetChange("data_disks")
+	oDisks, nDisks := oRaw.([]interface{}), nRaw.([]interface{})
+	timeout := int(d.Timeout(schema.TimeoutUpdate) / time.Second)
+
+	for i := len(oDisks) - 1; i >= len(nDisks); i-- {
+		// the data disk has been detached outside of Terraform
+		if i >= len(dataVolumes) {
+			log.Printf("[WARN] the data disk %d of BMS instance (%s) is not attached", i+1, d.Id())
+			continue
+		}
+
+		volumeId := dataVolumes[i].ID
+		job, err := baremetalservers.DetachVolume(bmsClient, d.Id(), volumeId).ExtractJobResponse()
+		if err != nil {
+			return fmt.Errorf("error detaching the data disk (%s) from BMS instance (%s): %s", volumeId, d.Id(), err)
+		}
+		if err := baremetalservers.WaitForJobSuccess(bmsClient, timeout, job.JobID); err != nil {
+			return fmt.Errorf("error waiting for the data disk (%s) to be detached: %s", volumeId, err)
+		}
+		if !d.Get("delete_disks_on_termination").(bool) {
+			log.Printf("[DEBUG] the data disk (%s) detached from BMS instance (%s) is kept", volumeId, d.Id())
+			continue
+		}
+		if err := deleteBmsInstanceDataDisk(ctx, d, evsClient, volumeId); err != nil {
+			return err
+		}
+	}
+
+	for i := len(oDisks); i < len(nDisks); i++ {
+		disk := nDisks[i].(map[string]interface{})
+		volumeId, err := createBmsInstanceDataDisk(ctx, d, evsClient, i, disk)
+		if err != nil {
+			return err
+		}
+
+		attachOpts := baremetalservers.AttachVolumeOpts{
+			VolumeID: volumeId,
+		}
+		job, err := baremetalservers.AttachVolume(bmsClient, d.Id(), attachOpts).ExtractJobResponse()
+		if err != nil {
+			return fmt.Errorf("error attaching the data disk (%s) to BMS instance (%s): %s", volumeId, d.Id(), err)
+		}
+		if err := baremetalservers.WaitForJobSuccess(bmsClient, timeout, job.JobID); err != nil {
+			return fmt.Errorf("error waiting for the data disk (%s) to be attached: %s", volumeId, err)
+		}
+	}
+	return nil
+}
+
+func createBmsInstanceDataDisk(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	index int, disk map[string]interface{}) (string, error) {
+	createOpts := volumes.CreateOpts{
+		AvailabilityZone:    d.Get("availability_zone").(string),
+		Name:                fmt.Sprintf("%s-volume-%04d", d.Get("name").(string), index+1),
+		Size:                disk["size"].(int),
+		VolumeType:          disk["type"].(string),
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+	}
+	v, err := volumes.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error creating the data disk of BMS instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"downloading", "creating"},
+		Target:     []string{"available"},
+		Refresh:    evs.VolumeV2StateRefreshFunc(client, v.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("error waiting for the data disk (%s) to become available: %s", v.ID, err)
+	}
+	return v.ID, nil
+}
+
+func deleteBmsInstanceDataDisk(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	volumeId string) error {
+	if err := volumes.Delete(client, volumeId, nil).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting the data disk (%s) of BMS instance (%s): %s", volumeId, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    evs.VolumeV2StateRefreshFunc(client, volumeId),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the data disk (%s) to be deleted: %s", volumeId, err)
+	}
+	return nil
+}
+
+// updateBmsInstanceEIP unbinds the old EIP and binds the new one to the primary NIC, the new EIP is bound after the
+// old one is unbound completely.
+func updateBmsInstanceEIP(d *schema.ResourceData, client *golangsdk.ServiceClient, portId string) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	oRaw, nRaw := d.GetChange("eip_id")
+	if oldId := oRaw.(string); oldId != "" {
+		if err := eip.UnbindPort(client, oldId, portId, timeout); err != nil {
+			return fmt.Errorf("error unbinding EIP (%s) from BMS instance (%s): %s", oldId, d.Id(), err)
+		}
+	}
+	if newId := nRaw.(string); newId != "" {
+		if err := eip.BindPort(client, newId, portId, timeout); err != nil {
+			return fmt.Errorf("error binding EIP (%s) to BMS instance (%s): %s", newId, d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func doBmsPowerAction(client *golangsdk.ServiceClient, d *schema.ResourceData, action string) error {
+	powerOpts := baremetalservers.PowerOpts{
+		Servers: []baremetalservers.Server{
+			{Id: d.Id()},
+		},
+	}
+	// the type is required by the stop and reboot actions, the default type is SOFT
+	if action != "ON" {
+		powerOpts.Type = "SOFT"
+	}
+	op := action
+	if strings.HasPrefix(action, "FORCE-") {
+		powerOpts.Type = "HARD"
+		op = strings.TrimPrefix(action, "FORCE-")
+	}
+	job, err := baremetalservers.PowerAction(client, powerOpts, bmsPowerActionMap[op]).ExtractJobResponse()
+	if err != nil {
+		return fmt.Errorf("error doing power action (%s) for BMS instance (%s): %s", action, d.Id(), err)
+	}
+
+	if err := baremetalservers.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second),
+		job.JobID); err != nil {
+		return fmt.Errorf("error waiting for power action (%s) for BMS instance (%s): %s", action, d.Id(), err)
+	}
+	return nil
+}
+
 func resourceBmsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := config.GetHcsConfig(meta)
 	region := cfg.GetRegion(d)
@@ -500,27 +1069,35 @@ func flattenBmsInstanceNicsV1(d *schema.ResourceData, meta interface{},
 	addresses map[string][]baremetalservers.Address) []map[string]interface{} {
 
 	config := config.GetHcsConfig(meta)
-	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	networkingClient, err := config.NetworkingV1Client(config.GetRegion(d))
 	if err != nil {
 		logp.Printf("Error creating HuaweiCloudStack networking client: %s", err)
 	}
 
 	var network string
+	var primary bool
 	nics := []map[string]interface{}{}
-	// Loop through all networks and addresses.
-	for _, addrs := range addresses {
-		for _, addr := range addrs {
+	primaryNics := []map[string]interface{}{}
+	// Loop through all networks and addresses, the networks are sorted to keep the order of nics stable, and the
+	// primary NIC is always the first one.
+	networkIDs := make([]string, 0, len(addresses))
+	for k := range addresses {
+		networkIDs = append(networkIDs, k)
+	}
+	sort.Strings(networkIDs)
+	for _, networkID := range networkIDs {
+		for _, addr := range addresses[networkID] {
 			// Skip if not fixed ip
 			if addr.Type != "fixed" {
 				continue
 			}
 
-			p, err := ports.Get(networkingClient, addr.PortID).Extract()
+			p, err := ports.Get(networkingClient, addr.PortID)
 			if err != nil {
-				network = ""
+				network, primary = "", false
 				logp.Printf("[DEBUG] flattenInstanceNicsV1: failed to fetch port %s", addr.PortID)
 			} else {
-				network = p.NetworkID
+				network, primary = p.NetworkId, p.VifDetails.PrimaryInterface
 			}
 
 			v := map[string]interface{}{
@@ -529,9 +1106,14 @@ func flattenBmsInstanceNicsV1(d *schema.ResourceData, meta interface{},
 				"mac_address": addr.MacAddr,
 				"port_id":     addr.PortID,
 			}
-			nics = append(nics, v)
+			if primary {
+				primaryNics = append(primaryNics, v)
+			} else {
+				nics = append(nics, v)
+			}
 		}
 	}
+	nics = append(primaryNics, nics...)
 
 	logp.Printf("[DEBUG] flattenInstanceNicsV1: %#v", nics)
 	return nics
@@ -0,0 +1,116 @@
+package bms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/bms/v1/baremetalservers"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestBmsInstanceDataVolumes(t *testing.T) {
+	volumes := []baremetalservers.VolumeAttached{
+		{ID: "volume-aa", Device: "/dev/sdaa"},
+		{ID: "volume-c", Device: "/dev/sdc"},
+		{ID: "volume-a", Device: "/dev/sda", BootIndex: "0"},
+		{ID: "volume-z", Device: "/dev/sdz"},
+		{ID: "volume-ab", Device: "/dev/sdab"},
+		{ID: "volume-b", Device: "/dev/sdb"},
+	}
+
+	// the system disk is excluded, and /dev/sdaa is attached after /dev/sdz
+	var ids []string
+	for _, v := range bmsInstanceDataVolumes(volumes) {
+		ids = append(ids, v.ID)
+	}
+	th.AssertDeepEquals(t, []string{"volume-b", "volume-c", "volume-z", "volume-aa", "volume-ab"}, ids)
+
+	th.AssertEquals(t, 0, len(bmsInstanceDataVolumes(volumes[2:3])))
+}
+
+func testBmsInstanceDataDisksDiff(id string, newDisks []interface{}) error {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"data_disks": ResourceBmsInstance().Schema["data_disks"],
+		},
+		CustomizeDiff: bmsInstanceDataDisksDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id":                "server-id",
+			"data_disks.#":      "2",
+			"data_disks.0.type": "SSD",
+			"data_disks.0.size": "100",
+			"data_disks.1.type": "SAS",
+			"data_disks.1.size": "200",
+		},
+	}
+	if id == "" {
+		state = nil
+	}
+
+	raw := map[string]interface{}{"data_disks": newDisks}
+	_, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	return err
+}
+
+func TestBmsInstanceDataDisksDiff(t *testing.T) {
+	ssd100 := map[string]interface{}{"type": "SSD", "size": 100}
+	sas200 := map[string]interface{}{"type": "SAS", "size": 200}
+
+	cases := []struct {
+		name     string
+		id       string
+		newDisks []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "append a data disk",
+			id:       "server-id",
+			newDisks: []interface{}{ssd100, sas200, map[string]interface{}{"type": "SSD", "size": 50}},
+		},
+		{
+			name:     "remove the last data disk",
+			id:       "server-id",
+			newDisks: []interface{}{ssd100},
+		},
+		{
+			name:     "remove all data disks",
+			id:       "server-id",
+			newDisks: []interface{}{},
+		},
+		{
+			name:     "remove the first data disk",
+			id:       "server-id",
+			newDisks: []interface{}{sas200},
+			wantErr:  true,
+		},
+		{
+			name:     "change the size of a data disk",
+			id:       "server-id",
+			newDisks: []interface{}{ssd100, map[string]interface{}{"type": "SAS", "size": 300}},
+			wantErr:  true,
+		},
+		{
+			name:     "change the type of a data disk",
+			id:       "server-id",
+			newDisks: []interface{}{map[string]interface{}{"type": "SAS", "size": 100}, sas200},
+			wantErr:  true,
+		},
+		{
+			name:     "create the instance",
+			newDisks: []interface{}{sas200, ssd100},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := testBmsInstanceDataDisksDiff(tc.id, tc.newDisks)
+			th.AssertEquals(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +20,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cce/v3/clusters"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cce/v3/nodepools"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cce/v3/nodes"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/ecs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
@@ -38,6 +40,14 @@ func ResourceNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			config.SetTagsAllDiff,
+			ecs.ValidateFlavorDiff(ecs.FlavorDiffKeys{
+				Flavors:          []string{"flavor_id"},
+				AvailabilityZone: "availability_zone",
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -120,7 +130,8 @@ func ResourceNodePool() *schema.Resource {
 						},
 					}},
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			// charge info: charging_mode, period_unit, period, auto_renew
 			"charging_mode": common.SchemaChargingMode(nil),
 			"period_unit":   common.SchemaPeriodUnit(nil),
@@ -250,7 +261,7 @@ func buildPodSecurityGroups(ids []interface{}) []nodepools.PodSecurityGroupSpec
 	return groups
 }
 
-func buildNodePoolCreateOpts(d *schema.ResourceData) (*nodepools.CreateOpts, error) {
+func buildNodePoolCreateOpts(d *schema.ResourceData, cfg *config.HcsConfig) (*nodepools.CreateOpts, error) {
 	// Validate whether prepaid parameters are configured.
 	billingMode := 0
 	if d.Get("charging_mode").(string) == "prePaid" {
@@ -285,7 +296,7 @@ func buildNodePoolCreateOpts(d *schema.ResourceData) (*nodepools.CreateOpts, err
 				},
 				ExtendParam:           buildExtendParams(d),
 				Taints:                buildResourceNodeTaint(d),
-				UserTags:              utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+				UserTags:              utils.ExpandResourceTags(utils.GetResourceTags(d, cfg)),
 				InitializedConditions: utils.ExpandToStringList(d.Get("initialized_conditions").([]interface{})),
 			},
 			Autoscaling: nodepools.AutoscalingSpec{
@@ -342,7 +353,7 @@ func resourceNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error waiting for CCE cluster to be available: %s", err)
 	}
 
-	createOpts, err := buildNodePoolCreateOpts(d)
+	createOpts, err := buildNodePoolCreateOpts(d, cfg)
 	if err != nil {
 		return diag.Errorf("error creating CreateOpts structure of 'Create' method for CCE node pool: %s", err)
 	}
@@ -408,7 +419,7 @@ func resourceNodePoolRead(_ context.Context, d *schema.ResourceData, meta interf
 		d.Set("ecs_group_id", s.Spec.NodeManagement.ServerGroupReference),
 		d.Set("storage", flattenResourceNodeStorage(s.Spec.NodeTemplate.Storage)),
 		d.Set("security_groups", s.Spec.CustomSecurityGroups),
-		d.Set("tags", utils.TagsToMap(s.Spec.NodeTemplate.UserTags)),
+		utils.SetTagsAndTagsAll(d, cfg, utils.TagsToMap(s.Spec.NodeTemplate.UserTags)),
 		d.Set("status", s.Status.Phase),
 		d.Set("data_volumes", flattenResourceNodeDataVolume(d, s.Spec.NodeTemplate.DataVolumes)),
 		d.Set("root_volume", flattenResourceNodeRootVolume(d, s.Spec.NodeTemplate.RootVolume)),
@@ -438,7 +449,11 @@ func resourceNodePoolRead(_ context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
-func buildNodePoolUpdateOpts(d *schema.ResourceData) (*nodepools.UpdateOpts, error) {
+// buildNodePoolUpdateOpts builds the update options of the node pool, all user tags are replaced by the request, so
+// the ignored tags of the remote tags are kept.
+func buildNodePoolUpdateOpts(d *schema.ResourceData, cfg *config.HcsConfig,
+	remoteTags map[string]string) (*nodepools.UpdateOpts, error) {
+	userTags := utils.MergeIgnoredTags(cfg, utils.GetResourceTags(d, cfg), remoteTags)
 	updateOpts := nodepools.UpdateOpts{
 		Metadata: nodepools.UpdateMetaData{
 			Name: d.Get("name").(string),
@@ -453,7 +468,7 @@ func buildNodePoolUpdateOpts(d *schema.ResourceData) (*nodepools.UpdateOpts, err
 				Priority:              d.Get("priority").(int),
 			},
 			NodeTemplate: nodepools.UpdateNodeTemplate{
-				UserTags:              utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+				UserTags:              utils.ExpandResourceTags(userTags),
 				K8sTags:               buildResourceNodeK8sTags(d),
 				Taints:                buildResourceNodeTaint(d),
 				InitializedConditions: utils.ExpandToStringList(d.Get("initialized_conditions").([]interface{})),
@@ -471,12 +486,21 @@ func resourceNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error creating CCE v3 client: %s", err)
 	}
 
-	updateOpts, err := buildNodePoolUpdateOpts(d)
+	clusterId := d.Get("cluster_id").(string)
+	nodePoolId := d.Id()
+	nodePool, err := nodepools.Get(cceClient, clusterId, nodePoolId).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving CCE node pool (%s): %s", nodePoolId, err)
+	}
+	remoteTags := make(map[string]string)
+	for _, tag := range nodePool.Spec.NodeTemplate.UserTags {
+		remoteTags[tag.Key] = tag.Value
+	}
+
+	updateOpts, err := buildNodePoolUpdateOpts(d, cfg, remoteTags)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	clusterId := d.Get("cluster_id").(string)
-	nodePoolId := d.Id()
 	_, err = nodepools.Update(cceClient, clusterId, nodePoolId, updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("error updating CCE node pool (%s): %s", nodePoolId, err)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
@@ -32,6 +33,8 @@ func ResourceMicroserviceEngineConfiguration() *schema.Resource {
 			StateContext: resourceMicroserviceEngineConfigurationImportState,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			// Authentication and request parameters.
 			"auth_address": {
@@ -84,7 +87,8 @@ func ResourceMicroserviceEngineConfiguration() *schema.Resource {
 				Computed:    true,
 				Description: `The configuration status.`,
 			},
-			"tags": common.TagsForceNewSchema(),
+			"tags":     common.TagsForceNewSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			// Attributes
 			"created_at": {
@@ -111,13 +115,13 @@ func ResourceMicroserviceEngineConfiguration() *schema.Resource {
 	}
 }
 
-func buildMicroserviceEngineConfigurationCreateOpts(d *schema.ResourceData) map[string]interface{} {
+func buildMicroserviceEngineConfigurationCreateOpts(d *schema.ResourceData, cfg *config.HcsConfig) map[string]interface{} {
 	return map[string]interface{}{
 		"key":        d.Get("key").(string),
 		"value_type": d.Get("value_type").(string),
 		"value":      d.Get("value").(string),
 		"status":     d.Get("status").(string),
-		"labels":     d.Get("tags").(map[string]interface{}),
+		"labels":     utils.GetResourceTags(d, cfg),
 	}
 }
 
@@ -136,7 +140,7 @@ func resourceMicroserviceEngineConfigurationCreate(ctx context.Context, d *schem
 	}
 	createOpts := golangsdk.RequestOpts{
 		KeepResponseBody: true,
-		JSONBody:         buildMicroserviceEngineConfigurationCreateOpts(d),
+		JSONBody:         buildMicroserviceEngineConfigurationCreateOpts(d, config.GetHcsConfig(meta)),
 	}
 	if token != "" {
 		createOpts.MoreHeaders = map[string]string{
@@ -187,7 +191,7 @@ func QueryMicroserviceEngineConfiguration(client *golangsdk.ServiceClient, token
 	return utils.FlattenResponse(requestResp)
 }
 
-func resourceMicroserviceEngineConfigurationRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func resourceMicroserviceEngineConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		client   = common.NewCustomClient(true, d.Get("connect_address").(string), "v1", "default")
 		configId = d.Id()
@@ -211,7 +215,7 @@ func resourceMicroserviceEngineConfigurationRead(_ context.Context, d *schema.Re
 		d.Set("value_type", utils.PathSearch("value_type", respBody, nil)),
 		d.Set("value", utils.PathSearch("value", respBody, nil)),
 		d.Set("status", utils.PathSearch("status", respBody, nil)),
-		d.Set("tags", utils.PathSearch("labels", respBody, nil)),
+		utils.SetTagsAndTagsAll(d, config.GetHcsConfig(meta), utils.PathSearch("labels", respBody, nil)),
 		d.Set("created_at", utils.FormatTimeStampRFC3339(
 			int64(utils.PathSearch("create_time", respBody, float64(0)).(float64)), false)),
 		d.Set("updated_at", utils.FormatTimeStampRFC3339(
@@ -33,6 +33,8 @@ func ResourceCsmsSecret() *schema.Resource {
 			StateContext: resourceCsmsSecretImport,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -67,7 +69,8 @@ func ResourceCsmsSecret() *schema.Resource {
 				Sensitive: true,
 				StateFunc: utils.HashAndHexEncode,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"secret_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -115,8 +118,7 @@ func resourceCsmsSecretCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(id)
 
 	// Save tags
-	if t, ok := d.GetOk("tags"); ok {
-		tMaps := t.(map[string]interface{})
+	if tMaps := utils.GetResourceTags(d, cfg); len(tMaps) > 0 {
 		tagMaps := utils.ExpandResourceTags(tMaps)
 		err = tags.Create(client, serviceType, rst.ID, tagMaps).ExtractErr()
 		if err != nil {
@@ -164,7 +166,7 @@ func resourceCsmsSecretRead(_ context.Context, d *schema.ResourceData, meta inte
 		tagMap := utils.TagsToMap(resourceTags.Tags)
 		mErr = multierror.Append(
 			mErr,
-			d.Set("tags", tagMap),
+			utils.SetTagsAndTagsAll(d, cfg, tagMap),
 		)
 	} else {
 		log.Printf("[WARN] error querying CSMS secret tags (%s): %s", id, err)
@@ -262,8 +264,8 @@ func resourceCsmsSecretUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Update tags
-	if d.HasChange("tags") {
-		err = utils.UpdateResourceTags(client, d, serviceType, id)
+	if d.HasChanges("tags", "tags_all") {
+		err = utils.UpdateResourceTags(client, d, cfg, serviceType, id)
 		if err != nil {
 			return diag.Errorf("failed to update CSMS secret tags: %s", err)
 		}
@@ -0,0 +1,154 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/dcs/v2/availablezones"
+	"github.com/chnsz/golangsdk/openstack/dcs/v2/flavors"
+
+	hcsConfig "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+// validateDcsFlavorDiff checks the flavor and the availability zones of the instance against the region during plan,
+// and fails the plan with the valid values if they are unknown, or the flavor is sold out in the availability zones.
+// The values which are unknown until apply are not checked, and the failed queries are logged without failing the
+// plan.
+func validateDcsFlavorDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("flavor", "availability_zones") {
+		return nil
+	}
+
+	cfg := hcsConfig.GetHcsConfig(meta)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	// the flavor is checked in the region if the availability zones are unknown
+	var azCodes []string
+	if d.NewValueKnown("availability_zones") {
+		for _, v := range d.Get("availability_zones").([]interface{}) {
+			if err := validateDcsAvailabilityZone(cfg, region, v.(string)); err != nil {
+				return err
+			}
+			azCodes = append(azCodes, v.(string))
+		}
+	}
+
+	if !d.NewValueKnown("flavor") || !d.NewValueKnown("engine") || d.Get("flavor").(string) == "" {
+		return nil
+	}
+	return validateDcsFlavor(cfg, region, d.Get("engine").(string), d.Get("flavor").(string), azCodes)
+}
+
+func validateDcsAvailabilityZone(cfg *hcsConfig.HcsConfig, region, az string) error {
+	result, err := cfg.PlanCache.Get("dcs/availability_zones/"+region, func() (interface{}, error) {
+		client, err := cfg.Config.DcsV2Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating DCS Client(v2): %s", err)
+		}
+		list, err := availablezones.List(client)
+		if err != nil {
+			return nil, err
+		}
+
+		codes := make([]string, 0, len(list.AvailableZones))
+		for _, v := range list.AvailableZones {
+			codes = append(codes, v.Code)
+		}
+		sort.Strings(codes)
+		return codes, nil
+	})
+	if err != nil {
+		log.Printf("[WARN] unable to query the DCS availability zones of %s, skipping the validation: %s", region, err)
+		return nil
+	}
+
+	codes := result.([]string)
+	for _, code := range codes {
+		if code == az {
+			return nil
+		}
+	}
+	return fmt.Errorf("the availability zone %q is not available for DCS in %s, the valid values are: %s", az,
+		region, strings.Join(codes, ", "))
+}
+
+func validateDcsFlavor(cfg *hcsConfig.HcsConfig, region, engine, specCode string, azCodes []string) error {
+	result, err := cfg.PlanCache.Get("dcs/flavors/"+region, func() (interface{}, error) {
+		client, err := cfg.Config.DcsV2Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating DCS Client(v2): %s", err)
+		}
+		return flavors.List(client, &flavors.ListOpts{}).Extract()
+	})
+	if err != nil {
+		log.Printf("[WARN] unable to query the DCS flavors of %s, skipping the validation: %s", region, err)
+		return nil
+	}
+
+	var specCodes []string
+	var soldOutAz string
+	for _, flavor := range result.([]flavors.Flavor) {
+		if !strings.EqualFold(flavor.Engine, engine) {
+			continue
+		}
+		az := dcsFlavorSoldOutAz(getDcsFlavorAzCodes(flavor), azCodes)
+		if flavor.SpecCode == specCode {
+			if az == "" {
+				return nil
+			}
+			soldOutAz = az
+		}
+		if az == "" {
+			specCodes = append(specCodes, flavor.SpecCode)
+		}
+	}
+	sort.Strings(specCodes)
+
+	if soldOutAz != "" {
+		return fmt.Errorf("the flavor %q of %s is sold out in %s, the valid values are: %s", specCode, engine,
+			soldOutAz, strings.Join(specCodes, ", "))
+	}
+	return fmt.Errorf("the flavor %q of %s is not found in %s, the valid values are: %s", specCode, engine, region,
+		strings.Join(specCodes, ", "))
+}
+
+// getDcsFlavorAzCodes returns the codes of the availability zones in which the flavor can be bought, and nil if the
+// availability zones of the flavor are not returned.
+func getDcsFlavorAzCodes(flavor flavors.Flavor) []string {
+	if len(flavor.AvailableZones) == 0 {
+		return nil
+	}
+
+	codes := make([]string, 0)
+	for _, v := range flavor.AvailableZones {
+		codes = append(codes, v.AzCodes...)
+	}
+	return codes
+}
+
+// dcsFlavorSoldOutAz returns the first availability zone in which the flavor can not be bought, or an empty string if
+// it can be bought in all of them. The availability zones are not checked if the flavor does not return them (nil).
+func dcsFlavorSoldOutAz(flavorAzCodes, azCodes []string) string {
+	if flavorAzCodes == nil {
+		return ""
+	}
+
+	available := make(map[string]bool)
+	for _, code := range flavorAzCodes {
+		available[code] = true
+	}
+	for _, code := range azCodes {
+		if !available[code] {
+			return code
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package dcs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/dcs/v2/flavors"
+
+	hcsConfig "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestDcsFlavorSoldOutAz(t *testing.T) {
+	cases := []struct {
+		name          string
+		flavorAzCodes []string
+		azCodes       []string
+		want          string
+	}{
+		{
+			name:          "available in all availability zones",
+			flavorAzCodes: []string{"az1", "az2", "az3"},
+			azCodes:       []string{"az1", "az3"},
+			want:          "",
+		},
+		{
+			name:          "sold out in the second availability zone",
+			flavorAzCodes: []string{"az1", "az3"},
+			azCodes:       []string{"az1", "az2", "az4"},
+			want:          "az2",
+		},
+		{
+			name:          "sold out in all availability zones",
+			flavorAzCodes: []string{},
+			azCodes:       []string{"az1"},
+			want:          "az1",
+		},
+		{
+			name:    "availability zones of the flavor not returned",
+			azCodes: []string{"az1"},
+			want:    "",
+		},
+		{
+			name:          "availability zones unknown",
+			flavorAzCodes: []string{"az1"},
+			want:          "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			th.AssertEquals(t, tc.want, dcsFlavorSoldOutAz(tc.flavorAzCodes, tc.azCodes))
+		})
+	}
+}
+
+func TestValidateDcsFlavor(t *testing.T) {
+	cfg := &hcsConfig.HcsConfig{PlanCache: hcsConfig.NewPlanCache()}
+	// the flavors and availability zones of the region are cached, so they are not queried
+	_, err := cfg.PlanCache.Get("dcs/flavors/region-1", func() (interface{}, error) {
+		return []flavors.Flavor{
+			{Engine: "Redis", SpecCode: "redis.single.xu1.tiny.128"},
+			{Engine: "Redis", SpecCode: "redis.ha.xu1.tiny.r2.128"},
+			{Engine: "Memcached", SpecCode: "dcs.memcached.single_node"},
+		}, nil
+	})
+	th.AssertNoErr(t, err)
+	_, err = cfg.PlanCache.Get("dcs/availability_zones/region-1", func() (interface{}, error) {
+		return []string{"az1", "az2"}, nil
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, validateDcsFlavor(cfg, "region-1", "redis", "redis.single.xu1.tiny.128", []string{"az1"}))
+
+	err = validateDcsFlavor(cfg, "region-1", "Redis", "dcs.memcached.single_node", nil)
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.HasSuffix(err.Error(),
+		"is not found in region-1, the valid values are: redis.ha.xu1.tiny.r2.128, redis.single.xu1.tiny.128"))
+
+	th.AssertNoErr(t, validateDcsAvailabilityZone(cfg, "region-1", "az2"))
+	err = validateDcsAvailabilityZone(cfg, "region-1", "az3")
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.HasSuffix(err.Error(), "the valid values are: az1, az2"))
+}
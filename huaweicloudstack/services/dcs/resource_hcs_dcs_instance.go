@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmespath/go-jmespath"
@@ -27,6 +28,9 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+
+	hcsConfig "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	hcsUtils "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
 type ctxType string
@@ -84,6 +88,10 @@ func ResourceDcsInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			validateDcsFlavorDiff,
+			hcsConfig.SetTagsAllDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
@@ -282,6 +290,7 @@ func ResourceDcsInstance() *schema.Resource {
 			"auto_renew":    common.SchemaAutoRenewUpdatable(nil),
 			"auto_pay":      common.SchemaAutoPay(nil),
 			"tags":          common.TagsSchema(),
+			"tags_all":      common.TagsComputedSchema(),
 			"deleted_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -597,7 +606,7 @@ func resourceDcsInstancesCreate(ctx context.Context, d *schema.ResourceData, met
 		AccessUser:          d.Get("access_user").(string),
 		TemplateId:          d.Get("template_id").(string),
 		BssParam:            buildBssParamParams(d),
-		Tags:                buildDcsTagsParams(d.Get("tags").(map[string]interface{})),
+		Tags:                buildDcsTagsParams(hcsUtils.GetResourceTags(d, hcsConfig.GetHcsConfig(meta))),
 	}
 
 	// build and set rename command if configured.
@@ -924,7 +933,7 @@ func resourceDcsInstancesRead(ctx context.Context, d *schema.ResourceData, meta
 	// set tags
 	if resourceTags, err := tags.Get(client, "instances", d.Id()).Extract(); err == nil {
 		tagMap := utils.TagsToMap(resourceTags.Tags)
-		if err := d.Set("tags", tagMap); err != nil {
+		if err := hcsUtils.SetTagsAndTagsAll(d, hcsConfig.GetHcsConfig(meta), tagMap); err != nil {
 			return diag.Errorf("[DEBUG] error saving tag to state for DCS instance (%s): %s", d.Id(), err)
 		}
 	} else {
@@ -1062,9 +1071,9 @@ func resourceDcsInstancesUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// update tags
-	if d.HasChange("tags") {
-		oldVal, newVal := d.GetChange("tags")
-		err = updateDcsTags(client, d.Id(), oldVal.(map[string]interface{}), newVal.(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		oldVal, newVal := hcsUtils.GetResourceTagsChange(d, hcsConfig.GetHcsConfig(meta))
+		err = updateDcsTags(client, d.Id(), oldVal, newVal)
 		if err != nil {
 			return diag.FromErr(err)
 		}
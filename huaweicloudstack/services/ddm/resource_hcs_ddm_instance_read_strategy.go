@@ -3,11 +3,14 @@ package ddm
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"strings"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
@@ -19,6 +22,7 @@ var ddmInstanceReadStrategyNonUpdatableParams = []string{
 }
 
 // @API DDM PUT /v2/{project_id}/instances/{instance_id}/action/read-write-strategy
+// @API DDM GET /v1/{project_id}/instances/{instance_id}
 func ResourceDdmInstanceReadStrategy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDdmReadStrategyCreateOrUpdate,
@@ -26,6 +30,10 @@ func ResourceDdmInstanceReadStrategy() *schema.Resource {
 		UpdateContext: resourceDdmReadStrategyCreateOrUpdate,
 		DeleteContext: resourceDdmReadStrategyDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDdmReadStrategyImportState,
+		},
+
 		CustomizeDiff: config.FlexibleForceNew(ddmInstanceReadStrategyNonUpdatableParams),
 
 		Schema: map[string]*schema.Schema{
@@ -60,13 +68,7 @@ func ResourceDdmInstanceReadStrategy() *schema.Resource {
 				Description: `Specifies the list of read weights of the primary DB instance and its read replicas.`,
 			},
 
-			// Internal
-			"enable_force_new": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
-				Description:  utils.SchemaDesc("", utils.SchemaDescInput{Internal: true}),
-			},
+			"enable_force_new": config.EnableForceNewSchema(),
 		},
 	}
 }
@@ -114,8 +116,73 @@ func buildDbReadStrategyBodyParams(d *schema.ResourceData) map[string]interface{
 	return bodyParams
 }
 
-func resourceDdmReadStrategyRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+// resourceDdmReadStrategyRead checks whether the instance exists. The read weights can not be queried, so the weights
+// in the state are kept, which are the weights applied by the resource or specified in the import ID.
+func resourceDdmReadStrategyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+
+	getInstanceHttpUrl := "v1/{project_id}/instances/{instance_id}"
+	getInstanceClient, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	getInstancePath := getInstanceClient.Endpoint + getInstanceHttpUrl
+	getInstancePath = strings.ReplaceAll(getInstancePath, "{project_id}", getInstanceClient.ProjectID)
+	getInstancePath = strings.ReplaceAll(getInstancePath, "{instance_id}", d.Id())
+
+	getInstanceOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getInstanceResp, err := getInstanceClient.Request("GET", getInstancePath, &getInstanceOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDM instance")
+	}
+
+	getInstanceRespBody, err := utils.FlattenResponse(getInstanceResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if utils.PathSearch("status", getInstanceRespBody, "").(string) == "DELETED" {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDdmReadStrategyImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	readWeights := make([]map[string]interface{}, 0)
+	if len(parts) == 2 {
+		for _, v := range strings.Split(parts[1], ",") {
+			dbID, weight, ok := strings.Cut(v, ":")
+			if !ok || dbID == "" {
+				return nil, fmt.Errorf("invalid read weight %q in the import ID, must be <db_id>:<weight>", v)
+			}
+			weightValue, err := strconv.Atoi(weight)
+			if err != nil {
+				return nil, fmt.Errorf("invalid read weight %q in the import ID, the weight must be an integer", v)
+			}
+			readWeights = append(readWeights, map[string]interface{}{
+				"db_id":  dbID,
+				"weight": weightValue,
+			})
+		}
+	}
+
+	d.SetId(parts[0])
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("read_weights", readWeights),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
 }
 
 func resourceDdmReadStrategyDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
@@ -48,6 +48,8 @@ func ResourceDmsKafkaInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -205,7 +207,8 @@ func ResourceDmsKafkaInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			// HCS unique
 			"dr_enable": {
@@ -547,7 +550,7 @@ func createKafkaInstanceWithFlavor(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// set tags
-	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+	if tagRaw := utils.GetResourceTags(d, conf); len(tagRaw) > 0 {
 		createOpts.Tags = utils.ExpandResourceTags(tagRaw)
 	}
 	log.Printf("[DEBUG] Create DMS Kafka instance options: %#v", createOpts)
@@ -661,7 +664,7 @@ func createKafkaInstanceWithProductID(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// set tags
-	if tagsRaw := d.Get("tags").(map[string]interface{}); len(tagsRaw) > 0 {
+	if tagsRaw := utils.GetResourceTags(d, cfg); len(tagsRaw) > 0 {
 		createOpts.Tags = utils.ExpandResourceTags(tagsRaw)
 	}
 	log.Printf("[DEBUG] Create DMS Kafka instance options: %#v", createOpts)
@@ -799,7 +802,7 @@ func resourceDmsKafkaInstanceRead(_ context.Context, d *schema.ResourceData, met
 	// set tags
 	if resourceTags, err := tags.Get(client, engineKafka, d.Id()).Extract(); err == nil {
 		tagMap := utils.TagsToMap(resourceTags.Tags)
-		if err = d.Set("tags", tagMap); err != nil {
+		if err = utils.SetTagsAndTagsAll(d, cfg, tagMap); err != nil {
 			mErr = multierror.Append(mErr,
 				fmt.Errorf("error saving tags to state for DMS kafka instance (%s): %s", d.Id(), err))
 		}
@@ -864,9 +867,9 @@ func resourceDmsKafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		// update tags
-		if err = utils.UpdateResourceTags(client, d, engineKafka, d.Id()); err != nil {
+		if err = utils.UpdateResourceTags(client, d, cfg, engineKafka, d.Id()); err != nil {
 			mErr = multierror.Append(mErr, fmt.Errorf("error updating tags of Kafka instance: %s, err: %s",
 				d.Id(), err))
 		}
@@ -0,0 +1,173 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/recordsets"
+)
+
+func DataSourceDNSRecordsets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordsetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the ID of the zone to which the record sets belong.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the name of the record sets to be queried.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A", "AAAA", "MX", "CNAME", "TXT", "NS", "SRV", "SOA", "CAA",
+				}, false),
+				Description: "Specifies the type of the record sets to be queried.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the status of the record sets to be queried.",
+			},
+			"recordsets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        recordsetsRecordsetSchema(),
+				Description: "The list of the record sets.",
+			},
+		},
+	}
+}
+
+func recordsetsRecordsetSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the record set.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the record set.",
+			},
+			"zone_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the zone to which the record set belongs.",
+			},
+			"zone_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone to which the record set belongs.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the record set.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The DNS records of the record set.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time to live (TTL) of the record set, in seconds.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the record set.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the record set.",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the record set is created by default.",
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordsetsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	dnsClient, err := conf.DnsWithRegionClient(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	listOpts := recordsets.ListOpts{
+		Name:   d.Get("name").(string),
+		Type:   d.Get("type").(string),
+		Status: d.Get("status").(string),
+	}
+	allPages, err := recordsets.ListByZone(dnsClient, d.Get("zone_id").(string), listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("error querying DNS recordsets: %s", err)
+	}
+
+	allRecordsets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return diag.Errorf("error extracting DNS recordsets: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("recordsets", flattenDNSRecordsets(allRecordsets)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the DNS recordsets: %s", err)
+	}
+	return nil
+}
+
+func flattenDNSRecordsets(recordsetList []recordsets.RecordSet) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(recordsetList))
+	for i, recordset := range recordsetList {
+		result[i] = map[string]interface{}{
+			"id":          recordset.ID,
+			"name":        recordset.Name,
+			"zone_id":     recordset.ZoneID,
+			"zone_name":   recordset.ZoneName,
+			"type":        recordset.Type,
+			"records":     recordset.Records,
+			"ttl":         recordset.TTL,
+			"status":      recordset.Status,
+			"description": recordset.Description,
+			"default":     recordset.Default,
+		}
+	}
+	return result
+}
@@ -0,0 +1,211 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/zones"
+)
+
+func DataSourceDNSZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+				Description:  "Specifies the type of the zones to be queried.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the name of the zones to be queried.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the status of the zones to be queried.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the enterprise project ID of the zones to be queried.",
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        zonesZoneSchema(),
+				Description: "The list of the zones.",
+			},
+		},
+	}
+}
+
+func zonesZoneSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the zone.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the administrator managing the zone.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the zone.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time to live (TTL) of the zone, in seconds.",
+			},
+			"zone_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the zone.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the zone.",
+			},
+			"record_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the record sets in the zone.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the zone.",
+			},
+			"masters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The master DNS servers of the zone.",
+			},
+			"routers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the VPC associated with the private zone.",
+						},
+						"router_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the VPC associated with the private zone.",
+						},
+					},
+				},
+				Description: "The VPCs associated with the private zone.",
+			},
+		},
+	}
+}
+
+func dataSourceDNSZonesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+
+	var dnsClient *golangsdk.ServiceClient
+	var err error
+	zoneType := d.Get("zone_type").(string)
+	// the private zones are queried with the DNS region endpoint
+	if zoneType == "private" {
+		dnsClient, err = conf.DnsWithRegionClient(region)
+	} else {
+		dnsClient, err = conf.DnsV2Client(region)
+	}
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	listOpts := zones.ListOpts{
+		Type:                zoneType,
+		Name:                d.Get("name").(string),
+		Status:              d.Get("status").(string),
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+	}
+	allPages, err := zones.List(dnsClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("error querying DNS zones: %s", err)
+	}
+
+	allZones, err := zones.ExtractZones(allPages)
+	if err != nil {
+		return diag.Errorf("error extracting DNS zones: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("zones", flattenDNSZones(allZones)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the DNS zones: %s", err)
+	}
+	return nil
+}
+
+func flattenDNSZones(zoneList []zones.Zone) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(zoneList))
+	for i, zone := range zoneList {
+		routers := make([]map[string]interface{}, len(zone.Routers))
+		for j, router := range zone.Routers {
+			routers[j] = map[string]interface{}{
+				"router_id":     router.RouterID,
+				"router_region": router.RouterRegion,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"id":                    zone.ID,
+			"name":                  zone.Name,
+			"email":                 zone.Email,
+			"description":           zone.Description,
+			"ttl":                   zone.TTL,
+			"zone_type":             zone.ZoneType,
+			"status":                zone.Status,
+			"record_num":            zone.RecordNum,
+			"enterprise_project_id": zone.EnterpriseProjectID,
+			"masters":               zone.Masters,
+			"routers":               routers,
+		}
+	}
+	return result
+}
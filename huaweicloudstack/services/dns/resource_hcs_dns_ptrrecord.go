@@ -0,0 +1,276 @@
+package dns
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dns/v2/ptrrecords"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// The resource type of the PTR record in the tag APIs.
+const ptrRecordTagType = "DNS-ptr_record"
+
+func ResourceDNSPtrRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSPtrRecordCreate,
+		ReadContext:   resourceDNSPtrRecordRead,
+		UpdateContext: resourceDNSPtrRecordUpdate,
+		DeleteContext: resourceDNSPtrRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: config.SetTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDNSPtrRecordTags(d *schema.ResourceData, conf *config.HcsConfig) []ptrrecords.Tag {
+	tagMap := utils.GetResourceTags(d, conf)
+	if len(tagMap) < 1 {
+		return nil
+	}
+
+	tagList := make([]ptrrecords.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		tagList = append(tagList, ptrrecords.Tag{
+			Key:   k,
+			Value: v.(string),
+		})
+	}
+	return tagList
+}
+
+func resourceDNSPtrRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	dnsClient, err := conf.DnsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	createOpts := ptrrecords.CreateOpts{
+		PtrName:             d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		TTL:                 d.Get("ttl").(int),
+		Tags:                buildDNSPtrRecordTags(d, conf),
+		EnterpriseProjectID: common.GetEnterpriseProjectID(d, conf),
+	}
+
+	log.Printf("[DEBUG] Create options: %#v", createOpts)
+	fipID := d.Get("floatingip_id").(string)
+	n, err := ptrrecords.Create(dnsClient, region, fipID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating DNS PTR record: %s", err)
+	}
+
+	d.SetId(n.ID)
+	log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to become available", n.ID)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSPtrRecord(dnsClient, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for DNS PTR record (%s) to become ACTIVE for creation: %s", n.ID, err)
+	}
+
+	return resourceDNSPtrRecordRead(ctx, d, meta)
+}
+
+func resourceDNSPtrRecordRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	dnsClient, err := conf.DnsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	n, err := ptrrecords.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS PTR record")
+	}
+
+	log.Printf("[DEBUG] Retrieved PTR record %s: %#v", d.Id(), n)
+
+	// The ID of the PTR record is in the format of {region}:{floatingip_id}.
+	fipID := ""
+	if parts := strings.SplitN(n.ID, ":", 2); len(parts) == 2 {
+		fipID = parts[1]
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", n.PtrName),
+		d.Set("floatingip_id", fipID),
+		d.Set("description", n.Description),
+		d.Set("ttl", n.TTL),
+		d.Set("address", n.Address),
+		d.Set("enterprise_project_id", n.EnterpriseProjectID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting resource: %s", err)
+	}
+
+	if err := utils.SetResourceTagsToState(d, dnsClient, conf, ptrRecordTagType, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDNSPtrRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	dnsClient, err := conf.DnsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "ttl") {
+		// The PTR record is updated through the same request as the creation.
+		updateOpts := ptrrecords.CreateOpts{
+			PtrName:     d.Get("name").(string),
+			Description: d.Get("description").(string),
+			TTL:         d.Get("ttl").(int),
+		}
+
+		log.Printf("[DEBUG] Update options: %#v", updateOpts)
+		fipID := d.Get("floatingip_id").(string)
+		_, err = ptrrecords.Create(dnsClient, region, fipID, updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("error updating DNS PTR record: %s", err)
+		}
+
+		log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to become available", d.Id())
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    waitForDNSPtrRecord(dnsClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("error waiting for DNS PTR record (%s) to become ACTIVE for update: %s", d.Id(), err)
+		}
+	}
+
+	tagErr := utils.UpdateResourceTags(dnsClient, d, conf, ptrRecordTagType, d.Id())
+	if tagErr != nil {
+		return diag.Errorf("error updating tags of DNS PTR record %s: %s", d.Id(), tagErr)
+	}
+
+	return resourceDNSPtrRecordRead(ctx, d, meta)
+}
+
+func resourceDNSPtrRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	dnsClient, err := conf.DnsV2Client(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	err = ptrrecords.Delete(dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.Errorf("error deleting DNS PTR record: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    waitForDNSPtrRecord(dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for DNS PTR record (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForDNSPtrRecord(dnsClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := ptrrecords.Get(dnsClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] DNS PTR record (%s) current status: %s", ptr.ID, ptr.Status)
+		return ptr, parseStatus(ptr.Status), nil
+	}
+}
@@ -41,6 +41,8 @@ func ResourceDNSRecordset() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -89,7 +91,22 @@ complete host name ended with a dot.`,
 				ValidateFunc: validation.StringInSlice([]string{"ENABLE", "DISABLE"}, false),
 				Description:  `Specifies the status of the record set.`,
 			},
-			"tags": common.TagsSchema(),
+			"line_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the resolution line ID of the record set. Only public zone support.`,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+				Description:  `Specifies the weight of the record set. Only public zone support.`,
+			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -126,7 +143,7 @@ func resourceDNSRecordsetCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// createDNSRecordset: create DNS recordset.
-	if err := createDNSRecordset(createDNSRecordsetClient, d, zoneType); err != nil {
+	if err := createDNSRecordset(createDNSRecordsetClient, d, cfg, zoneType); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -148,7 +165,8 @@ func resourceDNSRecordsetCreate(ctx context.Context, d *schema.ResourceData, met
 	return resourceDNSRecordsetRead(ctx, d, meta)
 }
 
-func createDNSRecordset(recordsetClient *golangsdk.ServiceClient, d *schema.ResourceData, zoneType string) error {
+func createDNSRecordset(recordsetClient *golangsdk.ServiceClient, d *schema.ResourceData, cfg *config.HcsConfig,
+	zoneType string) error {
 	version := getApiVersionByZoneType(zoneType)
 	createDNSRecordsetHttpUrl := fmt.Sprintf("%s/zones/{zone_id}/recordsets", version)
 
@@ -162,7 +180,7 @@ func createDNSRecordset(recordsetClient *golangsdk.ServiceClient, d *schema.Reso
 			202,
 		},
 	}
-	createDNSRecordsetOpt.JSONBody = utils.RemoveNil(buildCreateDNSRecordsetBodyParams(d))
+	createDNSRecordsetOpt.JSONBody = utils.RemoveNil(buildCreateDNSRecordsetBodyParams(d, cfg))
 	createDNSRecordsetResp, err := recordsetClient.Request("POST", createDNSRecordsetPath,
 		&createDNSRecordsetOpt)
 	if err != nil {
@@ -200,7 +218,8 @@ func waitForDNSRecordsetCreateOrUpdate(ctx context.Context, recordsetClient *gol
 	return nil
 }
 
-func buildCreateDNSRecordsetBodyParams(d *schema.ResourceData) map[string]interface{} {
+func buildCreateDNSRecordsetBodyParams(d *schema.ResourceData, cfg *config.HcsConfig) map[string]interface{} {
+	// the weight is read from the raw config, as the weight 0 excludes the record set from the resolution
 	bodyParams := map[string]interface{}{
 		"name":        utils.ValueIgnoreEmpty(d.Get("name")),
 		"description": utils.ValueIgnoreEmpty(d.Get("description")),
@@ -208,7 +227,9 @@ func buildCreateDNSRecordsetBodyParams(d *schema.ResourceData) map[string]interf
 		"status":      utils.ValueIgnoreEmpty(d.Get("status")),
 		"ttl":         utils.ValueIgnoreEmpty(d.Get("ttl")),
 		"records":     utils.ValueIgnoreEmpty(d.Get("records")),
-		"tags":        utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+		"line":        utils.ValueIgnoreEmpty(d.Get("line_id")),
+		"weight":      utils.GetNestedObjectFromRawConfig(d.GetRawConfig(), "weight"),
+		"tags":        utils.ExpandResourceTagsMap(utils.GetResourceTags(d, cfg)),
 	}
 	return bodyParams
 }
@@ -267,6 +288,8 @@ func resourceDNSRecordsetRead(_ context.Context, d *schema.ResourceData, meta in
 		d.Set("type", utils.PathSearch("type", getDNSRecordsetRespBody, nil)),
 		d.Set("ttl", utils.PathSearch("ttl", getDNSRecordsetRespBody, nil)),
 		d.Set("records", utils.PathSearch("records", getDNSRecordsetRespBody, nil)),
+		d.Set("line_id", utils.PathSearch("line", getDNSRecordsetRespBody, nil)),
+		d.Set("weight", utils.PathSearch("weight", getDNSRecordsetRespBody, nil)),
 		d.Set("status", getDNSRecordsetStatus(getDNSRecordsetRespBody)),
 	)
 
@@ -275,18 +298,18 @@ func resourceDNSRecordsetRead(_ context.Context, d *schema.ResourceData, meta in
 	}
 
 	// set tags
-	if err := setDNSRecordsetTags(d, getDNSRecordsetClient, recordsetID, zoneType); err != nil {
+	if err := setDNSRecordsetTags(d, cfg, getDNSRecordsetClient, recordsetID, zoneType); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func setDNSRecordsetTags(d *schema.ResourceData, client *golangsdk.ServiceClient, id, zoneType string) error {
+func setDNSRecordsetTags(d *schema.ResourceData, cfg *config.HcsConfig, client *golangsdk.ServiceClient, id, zoneType string) error {
 	resourceType, err := utils.GetDNSRecordSetTagType(zoneType)
 	if err != nil {
 		return err
 	}
-	return utils.SetResourceTagsToState(d, client, resourceType, id)
+	return utils.SetResourceTagsToState(d, client, cfg, resourceType, id)
 }
 
 func getDNSRecordsetStatus(getDNSRecordsetRespBody interface{}) string {
@@ -325,6 +348,7 @@ func resourceDNSRecordsetUpdate(ctx context.Context, d *schema.ResourceData, met
 		"type",
 		"ttl",
 		"records",
+		"weight",
 	}
 	if d.HasChanges(updateDNSRecordsetChanges...) {
 		// updateDNSRecordset: Update DNS recordset
@@ -350,13 +374,13 @@ func resourceDNSRecordsetUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		resourceType, err := utils.GetDNSRecordSetTagType(zoneType)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		err = utils.UpdateResourceTags(recordsetClient, d, resourceType, recordsetID)
+		err = utils.UpdateResourceTags(recordsetClient, d, cfg, resourceType, recordsetID)
 		if err != nil {
 			return diag.Errorf("error updating DNS recordset tags: %s", err)
 		}
@@ -417,6 +441,7 @@ func buildUpdateDNSRecordsetBodyParams(d *schema.ResourceData) map[string]interf
 		"type":        utils.ValueIgnoreEmpty(d.Get("type")),
 		"ttl":         utils.ValueIgnoreEmpty(d.Get("ttl")),
 		"records":     utils.ValueIgnoreEmpty(d.Get("records")),
+		"weight":      utils.GetNestedObjectFromRawConfig(d.GetRawConfig(), "weight"),
 	}
 	return bodyParams
 }
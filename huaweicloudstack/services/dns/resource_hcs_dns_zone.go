@@ -35,6 +35,8 @@ func ResourceDNSZone() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -86,6 +88,8 @@ func ResourceDNSZone() *schema.Resource {
 					},
 				},
 			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"masters": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -210,6 +214,16 @@ func resourceDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// create tags
+	resourceType, err := utils.GetDNSZoneTagType(zoneType)
+	if err != nil {
+		return diag.Errorf("error getting resource type of DNS zone %s: %s", n.ID, err)
+	}
+
+	if tagErr := utils.CreateResourceTags(dnsClient, d, conf, resourceType, n.ID); tagErr != nil {
+		return diag.Errorf("error setting tags of DNS zone %s: %s", n.ID, tagErr)
+	}
+
 	log.Printf("[DEBUG] Created DNS zone %s: %#v", n.ID, n)
 	return resourceDNSZoneRead(ctx, d, meta)
 }
@@ -259,6 +273,16 @@ func resourceDNSZoneRead(_ context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error setting resource: %s", mErr)
 	}
 
+	// set tags
+	resourceType, err := utils.GetDNSZoneTagType(zoneInfo.ZoneType)
+	if err != nil {
+		log.Printf("[WARN] error getting resource type of DNS zone %s: %s", d.Id(), err)
+		return nil
+	}
+	if err := utils.SetResourceTagsToState(d, dnsClient, conf, resourceType, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -305,7 +329,7 @@ func resourceDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("error getting resource type of DNS zone %s: %s", d.Id(), err)
 	}
 
-	tagErr := utils.UpdateResourceTags(dnsClient, d, resourceType, d.Id())
+	tagErr := utils.UpdateResourceTags(dnsClient, d, conf, resourceType, d.Id())
 	if tagErr != nil {
 		return diag.Errorf("error updating tags of DNS zone %s: %s", d.Id(), tagErr)
 	}
@@ -0,0 +1,275 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/flavors"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ims/v2/cloudimages"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
+)
+
+// the availability zone of the CCE node pools which is chosen by the service
+const randomAvailabilityZone = "random"
+
+// FlavorDiffKeys are the attributes checked by ValidateFlavorDiff, the empty keys are not checked.
+type FlavorDiffKeys struct {
+	// Flavors are the attributes of the flavor ID, the first non-empty one is used.
+	Flavors          []string
+	AvailabilityZone string
+	ImageID          string
+	ImageName        string
+}
+
+// ValidateFlavorDiff returns a CustomizeDiffFunc which checks the flavor and the availability zone against the region
+// during plan, rather than failing the create API in the middle of apply. The plan fails with the valid values when
+// the availability zone is unknown, or the flavor is unknown, sold out in the availability zone or does not match the
+// architecture of the image.
+// The values which are unknown until apply are treated as not set, and the failed queries are logged without failing
+// the plan.
+func ValidateFlavorDiff(keys FlavorDiffKeys) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		watched := append([]string{keys.AvailabilityZone, keys.ImageID, keys.ImageName}, keys.Flavors...)
+		if d.Id() != "" && !hasDiffChange(d, watched...) {
+			return nil
+		}
+
+		cfg := config.GetHcsConfig(meta)
+		region := cfg.Region
+		if v, ok := d.GetOk("region"); ok {
+			region = v.(string)
+		}
+
+		// the flavors of the region are checked if the availability zone is not specified
+		az := getPlannedValue(d, keys.AvailabilityZone)
+		if az != "" && az != randomAvailabilityZone {
+			if err := validateAvailabilityZone(cfg, region, az); err != nil {
+				return err
+			}
+		} else {
+			az = ""
+		}
+
+		var flavorID string
+		for _, key := range keys.Flavors {
+			if flavorID = getPlannedValue(d, key); flavorID != "" {
+				break
+			}
+		}
+		if flavorID == "" {
+			return nil
+		}
+		flavor, err := validateFlavor(cfg, region, az, flavorID)
+		if err != nil || flavor == nil {
+			return err
+		}
+		return validateFlavorArchitecture(cfg, d, region, flavor, keys)
+	}
+}
+
+func hasDiffChange(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if key != "" && d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// getPlannedValue returns the planned value of the key, and an empty string if the value is unknown until apply.
+func getPlannedValue(d *schema.ResourceDiff, key string) string {
+	if key == "" || !d.NewValueKnown(key) {
+		return ""
+	}
+	return d.Get(key).(string)
+}
+
+func validateAvailabilityZone(cfg *config.HcsConfig, region, az string) error {
+	result, err := cfg.PlanCache.Get("ecs/availability_zones/"+region, func() (interface{}, error) {
+		client, err := cfg.ComputeV2Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating compute client: %s", err)
+		}
+		allPages, err := availabilityzones.List(client).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		zoneInfo, err := availabilityzones.ExtractAvailabilityZones(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		zones := make([]string, 0, len(zoneInfo))
+		for _, z := range zoneInfo {
+			if z.ZoneState.Available {
+				zones = append(zones, z.ZoneName)
+			}
+		}
+		sort.Strings(zones)
+		return zones, nil
+	})
+	if err != nil {
+		log.Printf("[WARN] unable to query the availability zones of %s, skipping the validation: %s", region, err)
+		return nil
+	}
+
+	zones := result.([]string)
+	for _, zone := range zones {
+		if zone == az {
+			return nil
+		}
+	}
+	return fmt.Errorf("the availability zone %q is not available in %s, the valid values are: %s", az, region,
+		strings.Join(zones, ", "))
+}
+
+// listCachedFlavors returns the flavors of the availability zone, or the flavors of the region if the availability
+// zone is empty.
+func listCachedFlavors(cfg *config.HcsConfig, region, az string) ([]flavors.Flavor, error) {
+	result, err := cfg.PlanCache.Get(fmt.Sprintf("ecs/flavors/%s/%s", region, az), func() (interface{}, error) {
+		client, err := cfg.ComputeV1Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating ECS client: %s", err)
+		}
+		pages, err := flavors.List(client, &flavors.ListOpts{AvailabilityZone: az}).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		return flavors.ExtractFlavors(pages)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]flavors.Flavor), nil
+}
+
+// validateFlavor returns the flavor if it can be bought in the availability zone, and nil if the flavors can not be
+// queried.
+func validateFlavor(cfg *config.HcsConfig, region, az, flavorID string) (*flavors.Flavor, error) {
+	allFlavors, err := listCachedFlavors(cfg, region, az)
+	if err != nil {
+		log.Printf("[WARN] unable to query the flavors of %s, skipping the validation: %s", region, err)
+		return nil, nil
+	}
+
+	var validFlavors []string
+	var found *flavors.Flavor
+	for i, flavor := range allFlavors {
+		if flavorSoldOut(flavor, az) {
+			if flavor.ID == flavorID {
+				found = &allFlavors[i]
+			}
+			continue
+		}
+		if flavor.ID == flavorID {
+			return &allFlavors[i], nil
+		}
+		validFlavors = append(validFlavors, flavor.ID)
+	}
+	sort.Strings(validFlavors)
+
+	location := region
+	if az != "" {
+		location = az
+	}
+	if found != nil {
+		return nil, fmt.Errorf("the flavor %q is sold out in %s, the valid values are: %s", flavorID, location,
+			strings.Join(validFlavors, ", "))
+	}
+	return nil, fmt.Errorf("the flavor %q is not found in %s, the valid values are: %s", flavorID, location,
+		strings.Join(validFlavors, ", "))
+}
+
+// flavorSoldOut returns whether the flavor can not be bought in the availability zone. The status of the availability
+// zones is formatted as `az1(normal),az2(sellout)`, and the status of the region is used for the zones not listed.
+func flavorSoldOut(flavor flavors.Flavor, az string) bool {
+	status := flavor.OsExtraSpecs.OperationStatus
+	if az != "" {
+		for _, v := range strings.Split(flavor.OsExtraSpecs.OperationAz, ",") {
+			name, azStatus, ok := strings.Cut(strings.TrimSuffix(strings.TrimSpace(v), ")"), "(")
+			if ok && name == az {
+				status = azStatus
+				break
+			}
+		}
+	}
+	return status == "sellout" || status == "abandon"
+}
+
+func validateFlavorArchitecture(cfg *config.HcsConfig, d *schema.ResourceDiff, region string, flavor *flavors.Flavor,
+	keys FlavorDiffKeys) error {
+	architecture := flavor.OsExtraSpecs.InstanceArchitecture
+	if architecture == "" {
+		return nil
+	}
+	imageID := getPlannedValue(d, keys.ImageID)
+	imageName := getPlannedValue(d, keys.ImageName)
+	if imageID == "" && imageName == "" {
+		return nil
+	}
+
+	result, err := cfg.PlanCache.Get(fmt.Sprintf("ims/images/%s/%s/%s", region, imageID, imageName),
+		func() (interface{}, error) {
+			client, err := cfg.ImageV2Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating IMS client: %s", err)
+			}
+			return getImage(client, imageID, imageName)
+		})
+	if err != nil {
+		log.Printf("[WARN] unable to query the image, skipping the validation of the image architecture: %s", err)
+		return nil
+	}
+	image := result.(*cloudimages.Image)
+
+	if imageArchitecture := getImageArchitecture(image); imageArchitecture != architecture {
+		return fmt.Errorf("the architecture of the flavor %q is %s, which does not match the image %q (%s)",
+			flavor.ID, architecture, image.ID, imageArchitecture)
+	}
+	return nil
+}
+
+// getImageArchitecture returns the architecture of the image in the same format as the instance architecture of the
+// flavors, which is x86 or arm64.
+func getImageArchitecture(image *cloudimages.Image) string {
+	if image.SupportArm == "true" {
+		return "arm64"
+	}
+	return "x86"
+}
+
+// flavorQuotaAmount returns a quota.AmountFunc which returns the cores or the RAM (MB) of the planned flavor.
+func flavorQuotaAmount(quotaType string) quota.AmountFunc {
+	return func(d *schema.ResourceDiff, cfg *config.HcsConfig, region string) (int, error) {
+		flavorID := getPlannedValue(d, "flavor_id")
+		if flavorID == "" {
+			flavorID = getPlannedValue(d, "flavor_name")
+		}
+		if flavorID == "" {
+			return 0, nil
+		}
+
+		allFlavors, err := listCachedFlavors(cfg, region, "")
+		if err != nil {
+			return 0, err
+		}
+		for _, flavor := range allFlavors {
+			if flavor.ID != flavorID {
+				continue
+			}
+			if quotaType == "ram" {
+				return int(flavor.Ram), nil
+			}
+			return strconv.Atoi(flavor.Vcpus)
+		}
+		return 0, fmt.Errorf("the flavor %q is not found", flavorID)
+	}
+}
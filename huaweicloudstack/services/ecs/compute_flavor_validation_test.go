@@ -0,0 +1,121 @@
+package ecs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/flavors"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ims/v2/cloudimages"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func newTestFlavor(id, status, azStatus string) flavors.Flavor {
+	return flavors.Flavor{
+		ID: id,
+		OsExtraSpecs: flavors.OsExtraSpecs{
+			OperationStatus: status,
+			OperationAz:     azStatus,
+		},
+	}
+}
+
+func TestFlavorSoldOut(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   string
+		azStatus string
+		az       string
+		want     bool
+	}{
+		{name: "normal in region", status: "normal", want: false},
+		{name: "sold out in region", status: "sellout", want: true},
+		{name: "abandoned in region", status: "abandon", want: true},
+		{name: "promotion in region", status: "promotion", want: false},
+		{name: "empty status", want: false},
+		{
+			name:     "sold out in the availability zone",
+			status:   "normal",
+			azStatus: "az1(normal),az2(sellout)",
+			az:       "az2",
+			want:     true,
+		},
+		{
+			name:     "normal in the availability zone",
+			status:   "sellout",
+			azStatus: "az1(normal),az2(sellout)",
+			az:       "az1",
+			want:     false,
+		},
+		{
+			name:     "spaces between the availability zones",
+			status:   "normal",
+			azStatus: "az1(normal), az2(abandon)",
+			az:       "az2",
+			want:     true,
+		},
+		{
+			name:     "availability zone not listed",
+			status:   "sellout",
+			azStatus: "az1(normal)",
+			az:       "az3",
+			want:     true,
+		},
+		{
+			name:     "availability zone with a similar name",
+			status:   "normal",
+			azStatus: "az1(normal),az10(sellout)",
+			az:       "az1",
+			want:     false,
+		},
+		{
+			name:     "region level check",
+			status:   "normal",
+			azStatus: "az1(sellout)",
+			want:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			th.AssertEquals(t, tc.want, flavorSoldOut(newTestFlavor("s6.large.2", tc.status, tc.azStatus), tc.az))
+		})
+	}
+}
+
+func TestValidateFlavor(t *testing.T) {
+	cfg := &config.HcsConfig{PlanCache: config.NewPlanCache()}
+	// the flavors of the availability zone are cached, so they are not queried
+	_, err := cfg.PlanCache.Get("ecs/flavors/region-1/az1", func() (interface{}, error) {
+		return []flavors.Flavor{
+			newTestFlavor("s6.large.2", "normal", "az1(normal),az2(sellout)"),
+			newTestFlavor("s6.xlarge.2", "normal", "az1(sellout),az2(normal)"),
+			newTestFlavor("c6.large.2", "sellout", ""),
+			newTestFlavor("c6.xlarge.2", "normal", ""),
+		}, nil
+	})
+	th.AssertNoErr(t, err)
+
+	flavor, err := validateFlavor(cfg, "region-1", "az1", "s6.large.2")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "s6.large.2", flavor.ID)
+
+	_, err = validateFlavor(cfg, "region-1", "az1", "s6.xlarge.2")
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "is sold out in az1"))
+	th.AssertEquals(t, true, strings.HasSuffix(err.Error(), "the valid values are: c6.xlarge.2, s6.large.2"))
+
+	_, err = validateFlavor(cfg, "region-1", "az1", "c6.large.2")
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "is sold out in az1"))
+
+	_, err = validateFlavor(cfg, "region-1", "az1", "m6.large.8")
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "is not found in az1"))
+}
+
+func TestGetImageArchitecture(t *testing.T) {
+	th.AssertEquals(t, "arm64", getImageArchitecture(&cloudimages.Image{SupportArm: "true"}))
+	th.AssertEquals(t, "x86", getImageArchitecture(&cloudimages.Image{SupportArm: "false"}))
+	th.AssertEquals(t, "x86", getImageArchitecture(&cloudimages.Image{}))
+}
@@ -281,7 +281,7 @@ func setEcsInstanceParams(d *schema.ResourceData, conf *config.HcsConfig, ecsCli
 		d.Set("key_pair", server.KeyName),
 		d.Set("user_data", server.UserData),
 		d.Set("enterprise_project_id", server.EnterpriseProjectID),
-		d.Set("tags", flattenEcsInstanceTags(server.Tags)),
+		d.Set("tags", flattenEcsInstanceTags(server.Tags, conf)),
 		d.Set("security_group_ids", flattenEcsInstanceSecurityGroupIds(server.SecurityGroups)),
 		d.Set("security_groups", flattenEcsInstanceSecurityGroups(server.SecurityGroups)),
 		d.Set("scheduler_hints", flattenEcsInstanceSchedulerHints(server.OsSchedulerHints)),
@@ -353,11 +353,14 @@ func flattenEcsInstanceSchedulerHints(hints cloudservers.OsSchedulerHints) []map
 	return result
 }
 
-func flattenEcsInstanceTags(tags []string) map[string]interface{} {
+func flattenEcsInstanceTags(tags []string, conf *config.HcsConfig) map[string]interface{} {
 	result := map[string]interface{}{}
 
 	for _, tag := range tags {
 		kv := strings.SplitN(tag, ".", 2)
+		if utils.IsIgnoredTagKey(conf, kv[0]) {
+			continue
+		}
 		if len(kv) == 2 {
 			result[kv[0]] = kv[1]
 		} else {
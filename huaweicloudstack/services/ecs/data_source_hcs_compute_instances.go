@@ -229,7 +229,7 @@ func setComputeInstancesParams(d *schema.ResourceData, conf *config.HcsConfig, s
 			"enterprise_project_id": item.EnterpriseProjectID,
 			"user_data":             item.UserData,
 			"key_pair":              item.KeyName,
-			"tags":                  flattenEcsInstanceTags(item.Tags),
+			"tags":                  flattenEcsInstanceTags(item.Tags, conf),
 			"security_group_ids":    flattenEcsInstanceSecurityGroupIds(item.SecurityGroups),
 			"scheduler_hints":       flattenEcsInstanceSchedulerHints(item.OsSchedulerHints),
 		}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,6 +30,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/subnets"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v2/ports"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/evs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
@@ -51,6 +53,19 @@ func ResourceComputeInstance() *schema.Resource {
 			StateContext: resourceComputeInstanceImportState,
 		},
 
+		CustomizeDiff: customdiff.All(
+			ValidateFlavorDiff(FlavorDiffKeys{
+				Flavors:          []string{"flavor_id", "flavor_name"},
+				AvailabilityZone: "availability_zone",
+				ImageID:          "image_id",
+				ImageName:        "image_name",
+			}),
+			quota.PrecheckDiff("ecs", "instances", nil),
+			quota.PrecheckDiff("ecs", "cores", flavorQuotaAmount("cores")),
+			quota.PrecheckDiff("ecs", "ram", flavorQuotaAmount("ram")),
+			config.SetTagsAllDiff,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -385,11 +400,8 @@ func ResourceComputeInstance() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"power_action": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -542,11 +554,11 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		if !checkTags(tags.(map[string]interface{})) {
+	if tags := utils.GetResourceTags(d, cfg); len(tags) > 0 {
+		if !checkTags(tags) {
 			return diag.Errorf("tags check failed")
 		}
-		tagList := utils.ExpandResourceTagsString(tags.(map[string]interface{}))
+		tagList := utils.ExpandResourceTagsString(tags)
 		for _, tag := range tagList {
 			createOpts.Tags = append(createOpts.Tags, tag.(string))
 		}
@@ -802,7 +814,9 @@ func resourceComputeInstanceRead(_ context.Context, d *schema.ResourceData, meta
 		}
 		d.Set("scheduler_hints", schedulerHints)
 	}
-	d.Set("tags", flattenTagsToMap(server.Tags))
+	if err := utils.SetTagsAndTagsAll(d, cfg, utils.FlattenKeyValueTags(cfg, server.Tags)); err != nil {
+		return diag.Errorf("error saving tags of instance: %s", err)
+	}
 	return nil
 }
 
@@ -915,9 +929,8 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
-
-		tagErr := UpdateResourceTags(computeClient, d, "servers", d.Id())
+	if d.HasChanges("tags", "tags_all") {
+		tagErr := UpdateResourceTags(computeClient, d, cfg, "servers", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of instance:%s, err:%s", d.Id(), tagErr)
 		}
@@ -1070,7 +1083,9 @@ func resourceComputeInstanceImportState(_ context.Context, d *schema.ResourceDat
 
 	log.Printf("[DEBUG] flatten Instance Networks: %#v", networks)
 	d.Set("network", networks)
-	d.Set("tags", flattenTagsToMap(server.Tags))
+	if err := utils.SetTagsAndTagsAll(d, cfg, utils.FlattenKeyValueTags(cfg, server.Tags)); err != nil {
+		return nil, fmt.Errorf("error saving tags of instance: %s", err)
+	}
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1624,10 +1639,10 @@ func checkTags(tagMap map[string]interface{}) bool {
 	return true
 }
 
-func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, id string) error {
-	oRaw, nRaw := d.GetChange("tags")
-	oMap := oRaw.(map[string]interface{})
-	nMap := nRaw.(map[string]interface{})
+// UpdateResourceTags updates the effective tags of the instance, which are saved in tags_all.
+func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, conf utils.TagsConfig,
+	resourceType, id string) error {
+	oMap, nMap := utils.GetResourceTagsChange(d, conf)
 	if !checkTags(nMap) {
 		return fmt.Errorf("tags check failed")
 	}
@@ -1658,14 +1673,3 @@ func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, r
 	}
 	return nil
 }
-
-func flattenTagsToMap(tags []string) map[string]string {
-	result := make(map[string]string)
-	for _, tagStr := range tags {
-		tag := strings.SplitN(tagStr, ".", 2)
-		if len(tag) == 2 {
-			result[tag[0]] = tag[1]
-		}
-	}
-	return result
-}
@@ -2,16 +2,24 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/clone"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/cloudservers"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/ims"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
-	"log"
-	"time"
 )
 
+// @API ECS GET /v1/{project_id}/cloudservers/detail
 func ResourceComputeInstanceClone() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceComputeInstanceClone,
@@ -19,6 +27,10 @@ func ResourceComputeInstanceClone() *schema.Resource {
 		DeleteContext: resourceComputeInstanceCloneDelete,
 		UpdateContext: resourceComputeInstanceCloneUpdate,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComputeInstanceCloneImportState,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -55,6 +67,7 @@ func ResourceComputeInstanceClone() *schema.Resource {
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"network": {
@@ -73,6 +86,7 @@ func ResourceComputeInstanceClone() *schema.Resource {
 							Type:     schema.TypeString,
 							ForceNew: true,
 							Optional: true,
+							Computed: true,
 						},
 						"ipv6_enable": {
 							Type:     schema.TypeBool,
@@ -82,11 +96,13 @@ func ResourceComputeInstanceClone() *schema.Resource {
 						"fixed_ip_v6": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ForceNew: true,
 						},
 						"security_group_ids": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							ForceNew: true,
 							MaxItems: 5,
 							Elem: &schema.Resource{
@@ -230,14 +246,116 @@ func buildNicsReqBody(d *schema.ResourceData) []clone.NicsOpts {
 	return nicsRequests
 }
 
+// getClonedServer returns the server cloned from the source instance by its name, or nil if it does not exist.
+func getClonedServer(client *golangsdk.ServiceClient, sourceId, name string) (*cloudservers.CloudServer, error) {
+	// the name filter is fuzzy matched, so the exact name is checked
+	servers, err := queryEcsInstances(client, &cloudservers.ListOpts{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	for i := range servers {
+		if servers[i].Name == name && servers[i].ID != sourceId && servers[i].Status != "DELETED" {
+			return &servers[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func flattenComputeInstanceCloneNetwork(d *schema.ResourceData, meta interface{},
+	server *cloudservers.CloudServer) ([]map[string]interface{}, error) {
+	nics, err := getInstanceAddresses(d, meta, server)
+	if err != nil {
+		return nil, err
+	}
+
+	secGroups := make([]map[string]interface{}, len(server.SecurityGroups))
+	for i, sg := range server.SecurityGroups {
+		secGroups[i] = map[string]interface{}{
+			"security_group_id": sg.ID,
+		}
+	}
+	networks := make([]map[string]interface{}, len(nics))
+	for i, nic := range nics {
+		networks[i] = map[string]interface{}{
+			"subnet_id":          nic.NetworkID,
+			"fixed_ip_v4":        nic.FixedIPv4,
+			"fixed_ip_v6":        nic.FixedIPv6,
+			"ipv6_enable":        nic.FixedIPv6 != "",
+			"security_group_ids": secGroups,
+		}
+	}
+	return networks, nil
+}
+
 func resourceComputeInstanceCloneRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name, ok := d.GetOk("name")
-	if ok {
-		d.Set("name", name)
-	} else {
-		d.Set("name", utils.RandomString(8))
+	sourceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	if name == "" || d.Id() != sourceId+"-"+name {
+		// the name of the cloned server is generated by the service if it is not specified, and the earlier versions
+		// stored a random name, so the server can not be found, keep the state as it is.
+		log.Printf("[WARN] the name of the cloned server (%s) is unknown, skip reading it", d.Id())
+		return nil
 	}
-	return nil
+
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	ecsClient, err := cfg.ComputeV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute V1 client: %s", err)
+	}
+
+	server, err := getClonedServer(ecsClient, sourceId, name)
+	if err != nil {
+		return diag.Errorf("error retrieving the server cloned from %s: %s", sourceId, err)
+	}
+	if server == nil {
+		log.Printf("[WARN] the server (%s) cloned from %s is not found, remove it from the state", name, sourceId)
+		d.SetId("")
+		return nil
+	}
+
+	networks, err := flattenComputeInstanceCloneNetwork(d, meta, server)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", server.Name),
+		d.Set("vpc_id", server.Metadata.VpcID),
+		d.Set("network", networks),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceComputeInstanceCloneImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import id, must be <instance_id>/<name>")
+	}
+
+	cfg := config.GetHcsConfig(meta)
+	ecsClient, err := cfg.ComputeV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating compute V1 client: %s", err)
+	}
+	server, err := getClonedServer(ecsClient, parts[0], parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the server cloned from %s: %s", parts[0], err)
+	}
+	if server == nil {
+		return nil, fmt.Errorf("the server (%s) cloned from %s is not found", parts[1], parts[0])
+	}
+
+	// the power state and the key pair are only set during import, they may be changed after the cloning.
+	d.SetId(parts[0] + "-" + parts[1])
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+		d.Set("power_on", server.Status == "ACTIVE"),
+		d.Set("key_pair", server.KeyName),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
 }
 
 func resourceComputeInstanceCloneDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,6 +19,7 @@ import (
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/bandwidths"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/eips"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
@@ -56,6 +57,8 @@ func ResourceVpcEIPV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: quota.PrecheckDiff("vpc", "publicIp", nil),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -392,13 +395,13 @@ func updateEipPortId(vpcV1Client *golangsdk.ServiceClient, d *schema.ResourceDat
 	newPort := new.(string)
 
 	if oldPort != "" {
-		err := unbindPort(vpcV1Client, resourceId, oldPort, timeout)
+		err := UnbindPort(vpcV1Client, resourceId, oldPort, timeout)
 		if err != nil {
 			log.Printf("[WARN] Error trying to unbind EIP (%s): %s", resourceId, err)
 		}
 	}
 	if newPort != "" {
-		err := bindPort(vpcV1Client, resourceId, newPort, timeout)
+		err := BindPort(vpcV1Client, resourceId, newPort, timeout)
 		if err != nil {
 			return fmt.Errorf("error binding EIP (%s) to port (%s): %s", resourceId, newPort, err)
 		}
@@ -473,7 +476,7 @@ func resourceVpcEipDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	timeout := d.Timeout(schema.TimeoutDelete)
 	if v, ok := d.GetOk("publicip.0.port_id"); ok {
 		portID := v.(string)
-		err = unbindPort(networkingClient, resourceId, portID, timeout)
+		err = UnbindPort(networkingClient, resourceId, portID, timeout)
 		if err != nil {
 			log.Printf("[WARN] Error trying to unbind eip %s :%s", resourceId, err)
 		}
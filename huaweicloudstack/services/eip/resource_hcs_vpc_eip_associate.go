@@ -140,7 +140,7 @@ func resourceEIPAssociateCreate(ctx context.Context, d *schema.ResourceData, met
 
 	// The maximum timeout of excution methods for associate EIP.
 	t := d.Timeout(schema.TimeoutCreate)
-	err = bindPort(vpcClient, publicID, portID, t)
+	err = BindPort(vpcClient, publicID, portID, t)
 	if err != nil {
 		return fmtp.DiagErrorf("Error associating EIP %s to port %s: %s", publicID, portID, err)
 	}
@@ -209,7 +209,7 @@ func resourceEIPAssociateDelete(_ context.Context, d *schema.ResourceData, meta
 	}
 
 	portID := d.Get("port_id").(string)
-	err = unbindPort(vpcClient, d.Id(), portID, d.Timeout(schema.TimeoutDelete))
+	err = UnbindPort(vpcClient, d.Id(), portID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmtp.DiagErrorf("Error disassociating EIP %s from port %s: %s",
 			d.Id(), portID, err)
@@ -218,12 +218,14 @@ func resourceEIPAssociateDelete(_ context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func bindPort(client *golangsdk.ServiceClient, eipID, portID string, timeout time.Duration) error {
+// BindPort binds the EIP to the port and waits until the status of the EIP is DOWN or ACTIVE.
+func BindPort(client *golangsdk.ServiceClient, eipID, portID string, timeout time.Duration) error {
 	logp.Printf("[DEBUG] Bind EIP %s to port %s", eipID, portID)
 	return actionOnPort(client, eipID, portID, timeout)
 }
 
-func unbindPort(client *golangsdk.ServiceClient, eipID, portID string, timeout time.Duration) error {
+// UnbindPort unbinds the EIP from the port and waits until the status of the EIP is DOWN or ACTIVE.
+func UnbindPort(client *golangsdk.ServiceClient, eipID, portID string, timeout time.Duration) error {
 	logp.Printf("[DEBUG] Unbind EIP %s from port: %s", eipID, portID)
 	return actionOnPort(client, eipID, "", timeout)
 }
@@ -37,6 +37,8 @@ func ResourceListenerV3() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -150,7 +152,8 @@ func ResourceListenerV3() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 		},
 	}
 }
@@ -230,7 +233,7 @@ func resourceListenerV3Create(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(listener.ID)
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		elbV2Client, err := cfg.ElbV2Client(cfg.GetRegion(d))
 		if err != nil {
@@ -299,7 +302,9 @@ func resourceListenerV3Read(_ context.Context, d *schema.ResourceData, meta inte
 	// fetch tags
 	if resourceTags, err := tags.Get(elbV2Client, "listeners", d.Id()).Extract(); err == nil {
 		tagMap := utils.TagsToMap(resourceTags.Tags)
-		mErr = multierror.Append(mErr, d.Set("tags", tagMap))
+		mErr = multierror.Append(mErr,
+			utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		)
 	} else {
 		log.Printf("[WARN] fetching tags of ELB listener failed: %s", err)
 	}
@@ -330,12 +335,12 @@ func resourceListenerV3Update(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// update tags
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		elbV2Client, err := cfg.ElbV2Client(cfg.GetRegion(d))
 		if err != nil {
 			return diag.Errorf("error creating ELB 2.0 client: %s", err)
 		}
-		tagErr := utils.UpdateResourceTags(elbV2Client, d, "listeners", d.Id())
+		tagErr := utils.UpdateResourceTags(elbV2Client, d, cfg, "listeners", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of ELB listener:%s, err:%s", d.Id(), tagErr)
 		}
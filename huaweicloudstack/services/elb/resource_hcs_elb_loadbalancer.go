@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,6 +19,7 @@ import (
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
@@ -37,6 +39,11 @@ func ResourceLoadBalancerV3() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			config.SetTagsAllDiff,
+			quota.PrecheckDiff("elb", "loadbalancer", nil),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -164,7 +171,8 @@ func ResourceLoadBalancerV3() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			// charge info: charging_mode, period_unit, period, auto_renew, auto_pay
 			"charging_mode": common.SchemaChargingMode(nil),
@@ -320,7 +328,7 @@ func resourceLoadBalancerV3Create(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(loadBalancerID)
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		elbV2Client, err := cfg.ElbV2Client(cfg.GetRegion(d))
 		if err != nil {
@@ -389,7 +397,9 @@ func resourceLoadBalancerV3Read(_ context.Context, d *schema.ResourceData, meta
 	// fetch tags
 	if resourceTags, err := tags.Get(elbV2Client, "loadbalancers", d.Id()).Extract(); err == nil {
 		tagMap := utils.TagsToMap(resourceTags.Tags)
-		mErr = multierror.Append(mErr, d.Set("tags", tagMap))
+		mErr = multierror.Append(mErr,
+			utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		)
 	} else {
 		log.Printf("[WARN] Fetching tags of ELB LoadBalancer failed: %s", err)
 	}
@@ -430,12 +440,12 @@ func resourceLoadBalancerV3Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	// update tags
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		elbV2Client, err := cfg.ElbV2Client(cfg.GetRegion(d))
 		if err != nil {
 			return diag.Errorf("error creating ELB 2.0 client: %s", err)
 		}
-		tagErr := utils.UpdateResourceTags(elbV2Client, d, "loadbalancers", d.Id())
+		tagErr := utils.UpdateResourceTags(elbV2Client, d, cfg, "loadbalancers", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of LoadBalancer:%s, err:%s", d.Id(), tagErr)
 		}
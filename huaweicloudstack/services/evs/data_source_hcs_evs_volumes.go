@@ -10,6 +10,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/v2/volumes"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/fmtp"
 )
 
@@ -216,7 +217,7 @@ func sourceEvsAttachment(attachements []volumes.Attachment, metadata map[string]
 	return result
 }
 
-func sourceEvsVolumes(vols []volumes.Volume) ([]map[string]interface{}, []string, error) {
+func sourceEvsVolumes(vols []volumes.Volume, cfg *config.HcsConfig) ([]map[string]interface{}, []string, error) {
 	result := make([]map[string]interface{}, len(vols))
 	ids := make([]string, len(vols))
 
@@ -236,7 +237,7 @@ func sourceEvsVolumes(vols []volumes.Volume) ([]map[string]interface{}, []string
 			"updated_at":            volume.UpdatedAt,
 			"wwn":                   volume.WWN,
 			"metadata":              volume.Metadata,
-			"tags":                  volume.Tags,
+			"tags":                  utils.DeleteIgnoredTags(cfg, volume.Tags),
 		}
 
 		if volume.EncryptionInfo.CmkID != "" || volume.EncryptionInfo.Cipher != "" {
@@ -279,7 +280,7 @@ func dataSourceEvsVolumesV2Read(_ context.Context, d *schema.ResourceData, meta
 		return fmtp.DiagErrorf("Error getting the EVS volume list form server: %s", err)
 	}
 
-	vMap, ids, err := sourceEvsVolumes(vols)
+	vMap, ids, err := sourceEvsVolumes(vols, cfg)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -36,6 +36,8 @@ func ResourceEvsVolume() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -137,13 +139,8 @@ func ResourceEvsVolume() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
-			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -277,7 +274,7 @@ func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 	d.SetId(v.ID)
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		tagList := utils.ExpandResourceTags(tagRaw)
 		if tagErr := tags.Create(blockStorageClient, "cloudvolumes", v.ID, tagList).ExtractErr(); tagErr != nil {
@@ -315,9 +312,11 @@ func resourceEvsVolumeRead(_ context.Context, d *schema.ResourceData, meta inter
 	d.Set("updated_at", v.UpdatedAt)
 	d.Set("metadata", v.Metadata)
 	d.Set("multiattach", v.Multiattach)
-	d.Set("tags", v.Tags)
 	d.Set("enterprise_project_id", v.EnterpriseProjectID)
 	d.Set("region", cfg.GetRegion(d))
+	if err := utils.SetTagsAndTagsAll(d, cfg, v.Tags); err != nil {
+		return diag.Errorf("error saving tags of volume: %s", err)
+	}
 
 	if v.EncryptionInfo != nil {
 		encryptionInfo := map[string]interface{}{
@@ -392,8 +391,8 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		tagErr := utils.UpdateResourceTags(blockStorageClient, d, "cloudvolumes", d.Id())
+	if d.HasChanges("tags", "tags_all") {
+		tagErr := utils.UpdateResourceTags(blockStorageClient, d, cfg, "cloudvolumes", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of volume:%s, err:%s", d.Id(), tagErr)
 		}
@@ -53,6 +53,8 @@ func ResourceFgsFunction() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:        schema.TypeString,
@@ -337,7 +339,8 @@ func ResourceFgsFunction() *schema.Resource {
 				},
 				Description: `The versions management of the function.`,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"lts_custom_tag": {
 				Type:             schema.TypeMap,
 				Optional:         true,
@@ -1347,6 +1350,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if err = createFunctionTags(client, funcUrnWithoutVersion, utils.GetResourceTags(d, cfg)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceFunctionRead(ctx, d, meta)
 }
 
@@ -1738,6 +1745,7 @@ func resourceFunctionRead(_ context.Context, d *schema.ResourceData, meta interf
 		d.Set("restore_hook_timeout", utils.PathSearch("restore_hook_timeout", function, nil)),
 		d.Set("enable_lts_log", utils.PathSearch("enable_lts_log", function, nil)),
 		d.Set("tags", d.Get("tags")),
+		d.Set("tags_all", d.Get("tags_all")),
 		// Attributes.
 		d.Set("urn", utils.PathSearch("func_urn", function, nil)),
 		d.Set("version", utils.PathSearch("version", function, nil)),
@@ -1857,8 +1865,8 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		if err = updateFunctionTags(client, d, funcUrnWithoutVersion); err != nil {
+	if d.HasChanges("tags", "tags_all") {
+		if err = updateFunctionTags(client, d, cfg, funcUrnWithoutVersion); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -1903,10 +1911,9 @@ func deleteFunctionTags(client *golangsdk.ServiceClient, functionUrn string, tag
 	return nil
 }
 
-func updateFunctionTags(client *golangsdk.ServiceClient, d *schema.ResourceData, functionUrn string) error {
-	oldVal, newVal := d.GetChange("tags")
-	oldTags := oldVal.(map[string]interface{})
-	newTags := newVal.(map[string]interface{})
+func updateFunctionTags(client *golangsdk.ServiceClient, d *schema.ResourceData, cfg *config.HcsConfig,
+	functionUrn string) error {
+	oldTags, newTags := utils.GetResourceTagsChange(d, cfg)
 
 	if len(oldTags) > 0 {
 		if err := deleteFunctionTags(client, functionUrn, oldTags); err != nil {
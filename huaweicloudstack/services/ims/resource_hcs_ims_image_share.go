@@ -11,16 +11,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmespath/go-jmespath"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
+// @API IMS POST /v1/cloudimages/members
+// @API IMS DELETE /v1/cloudimages/members
+// @API IMS GET /v1/{project_id}/jobs/{job_id}
+// @API IMS GET /v2/images/{image_id}/members
 func ResourceImsImageShare() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceImsImageShareCreate,
@@ -28,6 +34,10 @@ func ResourceImsImageShare() *schema.Resource {
 		ReadContext:   resourceImsImageShareRead,
 		DeleteContext: resourceImsImageShareDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -94,8 +104,48 @@ func resourceImsImageShareUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceImsImageShareRead(ctx, d, meta)
 }
 
-func resourceImsImageShareRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceImsImageShareRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	var (
+		getImageMembersHttpUrl = "v2/images/{image_id}/members"
+		getImageMembersProduct = "ims"
+	)
+	getImageMembersClient, err := cfg.NewServiceClient(getImageMembersProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating IMS Client: %s", err)
+	}
+
+	getImageMembersPath := getImageMembersClient.Endpoint + getImageMembersHttpUrl
+	getImageMembersPath = strings.ReplaceAll(getImageMembersPath, "{image_id}", d.Id())
+
+	getImageMembersOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getImageMembersResp, err := getImageMembersClient.Request("GET", getImageMembersPath, &getImageMembersOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IMS image share")
+	}
+
+	getImageMembersRespBody, err := utils.FlattenResponse(getImageMembersResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectIds := utils.PathSearch("members[*].member_id", getImageMembersRespBody, make([]interface{}, 0))
+	if len(projectIds.([]interface{})) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("source_image_id", d.Id()),
+		d.Set("target_project_ids", projectIds),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
 }
 
 func resourceImsImageShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -7,46 +7,88 @@ package ims
 
 import (
 	"context"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmespath/go-jmespath"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
+// @API IMS PUT /v1/cloudimages/members
+// @API IMS GET /v1/{project_id}/jobs/{job_id}
+// @API IMS GET /v2/images/{image_id}/members/{member_id}
 func ResourceImsImageShareAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceImsImageShareAccepterCreate,
 		ReadContext:   resourceImsImageShareAccepterRead,
 		DeleteContext: resourceImsImageShareAccepterDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImsImageShareAccepterImportState,
+		},
+
+		// the ID of the resources created by the earlier versions is a random UUID, it is replaced with the image ID
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceImsImageShareAccepterV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceImsImageShareAccepterStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
-			"region": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-			},
-			"image_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `Specifies the ID of the image.`,
-			},
+		Schema: imsImageShareAccepterSchema(),
+	}
+}
+
+func imsImageShareAccepterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: `Specifies the ID of the image.`,
+		},
+	}
+}
+
+// resourceImsImageShareAccepterV0 is the resource of schema version 0, whose ID is a random UUID.
+func resourceImsImageShareAccepterV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
+		Schema: imsImageShareAccepterSchema(),
 	}
 }
 
+func resourceImsImageShareAccepterStateUpgradeV0(_ context.Context, rawState map[string]interface{},
+	_ interface{}) (map[string]interface{}, error) {
+	if imageId, ok := rawState["image_id"].(string); ok && imageId != "" {
+		rawState["id"] = imageId
+	}
+	return rawState, nil
+}
+
 func resourceImsImageShareAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := config.GetHcsConfig(meta)
 	region := cfg.GetRegion(d)
@@ -93,11 +135,7 @@ func resourceImsImageShareAccepterCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	resourceId, err := uuid.GenerateUUID()
-	if err != nil {
-		return diag.Errorf("unable to generate ID: %s", err)
-	}
-	d.SetId(resourceId)
+	d.SetId(d.Get("image_id").(string))
 
 	return resourceImsImageShareAccepterRead(ctx, d, meta)
 }
@@ -114,8 +152,58 @@ func buildCreateImageShareAccepterBodyParams(d *schema.ResourceData, projectId s
 	return bodyParams
 }
 
-func resourceImsImageShareAccepterRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourceImsImageShareAccepterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+
+	// getImageShareAccepter: query the member of the image in the current project
+	var (
+		getImageShareAccepterHttpUrl = "v2/images/{image_id}/members/{member_id}"
+		getImageShareAccepterProduct = "ims"
+	)
+	getImageShareAccepterClient, err := cfg.NewServiceClient(getImageShareAccepterProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating IMS Client: %s", err)
+	}
+
+	imageId := d.Get("image_id").(string)
+	getImageShareAccepterPath := getImageShareAccepterClient.Endpoint + getImageShareAccepterHttpUrl
+	getImageShareAccepterPath = strings.ReplaceAll(getImageShareAccepterPath, "{image_id}", imageId)
+	getImageShareAccepterPath = strings.ReplaceAll(getImageShareAccepterPath, "{member_id}",
+		getImageShareAccepterClient.ProjectID)
+
+	getImageShareAccepterOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getImageShareAccepterResp, err := getImageShareAccepterClient.Request("GET", getImageShareAccepterPath,
+		&getImageShareAccepterOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IMS image share accepter")
+	}
+
+	getImageShareAccepterRespBody, err := utils.FlattenResponse(getImageShareAccepterResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the image is rejected or not accepted yet
+	if utils.PathSearch("status", getImageShareAccepterRespBody, "").(string) != "accepted" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("image_id", imageId),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceImsImageShareAccepterImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, d.Set("image_id", d.Id())
 }
 
 func resourceImsImageShareAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
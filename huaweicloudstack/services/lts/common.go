@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
 // The tag field information.
@@ -34,10 +35,9 @@ func expandTagsToList(tagRaw map[string]interface{}) []TagsMap {
 	return taglist
 }
 
-func updateTags(client *golangsdk.ServiceClient, resourceType, resourceId string, d *schema.ResourceData) error {
-	oRaw, nRaw := d.GetChange("tags")
-	oMap := oRaw.(map[string]interface{})
-	nMap := nRaw.(map[string]interface{})
+func updateTags(client *golangsdk.ServiceClient, resourceType, resourceId string, d *schema.ResourceData,
+	conf utils.TagsConfig) error {
+	oMap, nMap := utils.GetResourceTagsChange(d, conf)
 
 	httpUrl := "v1/{project_id}/{resource_type}/{resource_id}/tags/action"
 	path := client.Endpoint + httpUrl
@@ -101,7 +101,7 @@ func getLogGroups(client *golangsdk.ServiceClient, _ *schema.ResourceData) ([]in
 	return logGroups, nil
 }
 
-func flattenLogGroups(logGroups []interface{}) []map[string]interface{} {
+func flattenLogGroups(logGroups []interface{}, cfg *config.HcsConfig) []map[string]interface{} {
 	if len(logGroups) < 1 {
 		return nil
 	}
@@ -114,7 +114,7 @@ func flattenLogGroups(logGroups []interface{}) []map[string]interface{} {
 			"name":        utils.PathSearch("log_group_name", logGroup, nil),
 			"ttl_in_days": utils.PathSearch("ttl_in_days", logGroup, nil),
 			"stream_size": utils.PathSearch("stream_size", logGroup, nil),
-			"tags":        utils.DeleteEnterpriseProjectIdFromTags(utils.PathSearch("tag", logGroup, nil)),
+			"tags":        utils.DeleteIgnoredTags(cfg, utils.PathSearch("tag", logGroup, nil)),
 			"created_at":  utils.FormatTimeStampRFC3339(int64(creationTime), false),
 		})
 	}
@@ -143,7 +143,7 @@ func dataSourceLogGroupsRead(_ context.Context, d *schema.ResourceData, meta int
 
 	mErr := multierror.Append(nil,
 		d.Set("region", region),
-		d.Set("log_groups", flattenLogGroups(logGroups)),
+		d.Set("log_groups", flattenLogGroups(logGroups, cfg)),
 	)
 
 	return diag.FromErr(mErr.ErrorOrNil())
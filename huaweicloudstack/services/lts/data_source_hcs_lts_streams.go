@@ -142,7 +142,7 @@ func getLogStreams(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]i
 	return result, nil
 }
 
-func flattenLogStreamsList(logStreams []interface{}) []map[string]interface{} {
+func flattenLogStreamsList(logStreams []interface{}, cfg *config.HcsConfig) []map[string]interface{} {
 	if len(logStreams) < 1 {
 		return nil
 	}
@@ -156,7 +156,7 @@ func flattenLogStreamsList(logStreams []interface{}) []map[string]interface{} {
 			"created_at":   utils.FormatTimeStampRFC3339(int64(creationTime), false),
 			"filter_count": utils.PathSearch("filter_count", logStream, nil),
 			"is_favorite":  utils.PathSearch("is_favorite", logStream, nil),
-			"tags":         utils.DeleteEnterpriseProjectIdFromTags(utils.PathSearch("tag", logStream, nil)),
+			"tags":         utils.DeleteIgnoredTags(cfg, utils.PathSearch("tag", logStream, nil)),
 		})
 	}
 
@@ -184,7 +184,7 @@ func dataSourceLogStreamsRead(_ context.Context, d *schema.ResourceData, meta in
 
 	mErr := multierror.Append(nil,
 		d.Set("region", region),
-		d.Set("log_streams", flattenLogStreamsList(logStreams)),
+		d.Set("log_streams", flattenLogStreamsList(logStreams, cfg)),
 	)
 
 	return diag.FromErr(mErr.ErrorOrNil())
@@ -32,6 +32,8 @@ func ResourceLTSGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -48,7 +50,8 @@ func ResourceLTSGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			// Attributes
 			"created_at": {
@@ -96,9 +99,9 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(logGroupId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if len(utils.GetResourceTags(d, cfg)) > 0 {
 		groupId := d.Id()
-		if err := updateTags(client, "groups", groupId, d); err != nil {
+		if err := updateTags(client, "groups", groupId, d, cfg); err != nil {
 			return diag.Errorf("error creating tags of log group %s: %s", groupId, err)
 		}
 	}
@@ -113,11 +116,6 @@ func buildCreateGroupBodyParams(d *schema.ResourceData) map[string]interface{} {
 	return bodyParams
 }
 
-func ignoreSysEpsTag(tags map[string]interface{}) map[string]interface{} {
-	delete(tags, "_sys_enterprise_project_id")
-	return tags
-}
-
 func resourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		cfg     = config.GetHcsConfig(meta)
@@ -152,10 +150,11 @@ func resourceGroupRead(_ context.Context, d *schema.ResourceData, meta interface
 		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, fmt.Sprintf("unable to find log group by its ID (%s)", groupId))
 	}
 
+	tagMap := utils.PathSearch("tag", groupResult, make(map[string]interface{}))
 	mErr := multierror.Append(nil,
 		d.Set("region", region),
 		d.Set("group_name", utils.PathSearch("log_group_name", groupResult, nil)),
-		d.Set("tags", ignoreSysEpsTag(utils.PathSearch("tag", groupResult, make(map[string]interface{})).(map[string]interface{}))),
+		utils.SetTagsAndTagsAll(d, cfg, tagMap),
 		d.Set("ttl_in_days", utils.PathSearch("ttl_in_days", groupResult, nil)),
 		d.Set("created_at", utils.FormatTimeStampRFC3339(int64(utils.PathSearch("creation_time", groupResult, 0).(float64))/1000, false)),
 	)
@@ -192,8 +191,8 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
-		if err := updateTags(client, "groups", groupId, d); err != nil {
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateTags(client, "groups", groupId, d, cfg); err != nil {
 			return diag.Errorf("error updating tags of log group %s: %s", groupId, err)
 		}
 	}
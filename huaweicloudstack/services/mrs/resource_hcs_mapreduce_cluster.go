@@ -64,6 +64,8 @@ func ResourceMRSClusterV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Update: schema.DefaultTimeout(3 * time.Hour),
@@ -241,11 +243,8 @@ func ResourceMRSClusterV2() *schema.Resource {
 				ForceNew: true,
 				Elem:     componentConfigsSchemaResource(),
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"bootstrap_scripts": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -739,7 +738,7 @@ func resourceMRSClusterV2Create(ctx context.Context, d *schema.ResourceData, met
 		SecurityGroupsIds:    strings.Join(utils.ExpandToStringListBySet(d.Get("security_group_ids").(*schema.Set)), ","),
 		ComponentConfigs:     buildComponentConfigOpts(d),
 		TemplateId:           d.Get("template_id").(string),
-		Tags:                 utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+		Tags:                 utils.ExpandResourceTags(utils.GetResourceTags(d, cfg)),
 		ExternalDatasources:  buildClusterExternalDatasources(d.Get("external_datasources")),
 		BootstrapScripts:     buildBootstrapScripts(d.Get("bootstrap_scripts").(*schema.Set)),
 	}
@@ -988,14 +987,14 @@ func parseHostIps(groupName string, isCustomNode bool, clustHostMap map[string][
 	return rt
 }
 
-func flattenTags(tagsString string) map[string]string {
+func flattenTags(tagsString string, cfg *config.HcsConfig) map[string]string {
 	// the format of tagsRaw: "d=d2,aa=aa"
 	result := make(map[string]string)
 	if len(tagsString) > 0 {
 		tagsArray := strings.Split(tagsString, ",")
 		for _, item := range tagsArray {
 			tag := strings.SplitN(item, "=", 2)
-			if len(tag) == 2 {
+			if len(tag) == 2 && !utils.IsIgnoredTagKey(cfg, tag[0]) {
 				result[tag[0]] = tag[1]
 			}
 		}
@@ -1076,7 +1075,7 @@ func resourceMRSClusterV2Read(_ context.Context, d *schema.ResourceData, meta in
 		setMrsClusterUpdateTimestamp(d, resp),
 		setMrsClusterChargingTimestamp(d, resp),
 		setMrsClusterNodeGroups(d, client, resp),
-		d.Set("tags", flattenTags(resp.Tags)),
+		utils.SetTagsAndTagsAll(d, cfg, flattenTags(resp.Tags, cfg)),
 		d.Set("bootstrap_scripts", flattenBootstrapScripts(resp.BootstrapScripts)),
 	)
 
@@ -1304,8 +1303,8 @@ func resourceMRSClusterV2Update(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
-		tagErr := updateResourceTagsWithSleep(client, d, "clusters", d.Id())
+	if d.HasChanges("tags", "tags_all") {
+		tagErr := updateResourceTagsWithSleep(client, d, cfg, "clusters", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of MRS cluster:%s, err:%s", d.Id(), tagErr)
 		}
@@ -1397,11 +1396,10 @@ func queryMrsClusterHosts(d *schema.ResourceData, mrsV1Client *golangsdk.Service
 	return hostsMap, nil
 }
 
-func updateResourceTagsWithSleep(conn *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, id string) error {
-	if d.HasChange("tags") {
-		oRaw, nRaw := d.GetChange("tags")
-		oMap := oRaw.(map[string]interface{})
-		nMap := nRaw.(map[string]interface{})
+func updateResourceTagsWithSleep(conn *golangsdk.ServiceClient, d *schema.ResourceData, conf utils.TagsConfig,
+	resourceType, id string) error {
+	if d.HasChanges("tags", "tags_all") {
+		oMap, nMap := utils.GetResourceTagsChange(d, conf)
 
 		// remove old tags
 		if len(oMap) > 0 {
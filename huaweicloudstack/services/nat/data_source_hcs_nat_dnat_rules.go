@@ -0,0 +1,244 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v2/dnats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func DataSourcePublicDnatRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePublicDnatRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the DNAT rules are located.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the DNAT rule.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the NAT gateway to which the DNAT rules belong.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "any"}, false),
+				Description:  "The protocol type of the DNAT rules.",
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The port ID of the backend instance of the DNAT rules.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The private IP address of the backend instance of the DNAT rules.",
+			},
+			"internal_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The port used by the backend instance to provide services for external systems.",
+			},
+			"external_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The port used by the floating IP to provide services for external systems.",
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the floating IP connected by the DNAT rules.",
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The floating IP address connected by the DNAT rules.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status of the DNAT rules.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the DNAT rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        publicDnatRuleSchema(),
+				Description: "The list of the DNAT rules.",
+			},
+		},
+	}
+}
+
+func publicDnatRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the DNAT rule.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the NAT gateway to which the DNAT rule belongs.",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol type of the DNAT rule.",
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port ID of the backend instance of the DNAT rule.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private IP address of the backend instance of the DNAT rule.",
+			},
+			"internal_service_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port used by the backend instance to provide services for external systems.",
+			},
+			"external_service_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port used by the floating IP to provide services for external systems.",
+			},
+			"internal_service_port_range": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port range used by the backend instance to provide services for external systems.",
+			},
+			"external_service_port_range": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port range used by the floating IP to provide services for external systems.",
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the floating IP connected by the DNAT rule.",
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The floating IP address connected by the DNAT rule.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the DNAT rule.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the DNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the DNAT rule.",
+			},
+		},
+	}
+}
+
+func buildPublicDnatRulesListOpts(d *schema.ResourceData) dnats.ListOpts {
+	opts := dnats.ListOpts{
+		ID:                d.Get("rule_id").(string),
+		GatewayId:         d.Get("nat_gateway_id").(string),
+		Protocol:          d.Get("protocol").(string),
+		PortId:            d.Get("port_id").(string),
+		PrivateIp:         d.Get("private_ip").(string),
+		FloatingIpId:      d.Get("floating_ip_id").(string),
+		FloatingIpAddress: d.Get("floating_ip_address").(string),
+		Status:            d.Get("status").(string),
+		Description:       d.Get("description").(string),
+	}
+	// the ports are checked by the raw config, because GetOk can not tell the port 0 from the omitted one
+	if !d.GetRawConfig().GetAttr("internal_service_port").IsNull() {
+		opts.InternalServicePort = utils.Int(d.Get("internal_service_port").(int))
+	}
+	if !d.GetRawConfig().GetAttr("external_service_port").IsNull() {
+		opts.ExternalServicePort = utils.Int(d.Get("external_service_port").(int))
+	}
+	return opts
+}
+
+func dataSourcePublicDnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	resp, err := dnats.List(client, buildPublicDnatRulesListOpts(d))
+	if err != nil {
+		return diag.Errorf("error querying DNAT rules: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("rules", flattenPublicDnatRules(resp)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the DNAT rules: %s", err)
+	}
+	return nil
+}
+
+func flattenPublicDnatRules(rules []dnats.Rule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"id":                          rule.ID,
+			"nat_gateway_id":              rule.GatewayId,
+			"protocol":                    rule.Protocol,
+			"port_id":                     rule.PortId,
+			"private_ip":                  rule.PrivateIp,
+			"internal_service_port":       rule.InternalServicePort,
+			"external_service_port":       rule.ExternalServicePort,
+			"internal_service_port_range": rule.InternalServicePortRange,
+			"external_service_port_range": rule.EXternalServicePortRange,
+			"floating_ip_id":              rule.FloatingIpId,
+			"floating_ip_address":         rule.FloatingIpAddress,
+			"status":                      rule.Status,
+			"description":                 rule.Description,
+			"created_at":                  rule.CreatedAt,
+		}
+	}
+	return result
+}
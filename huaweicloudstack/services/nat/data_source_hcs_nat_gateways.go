@@ -0,0 +1,210 @@
+package nat
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v2/gateways"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func DataSourcePublicGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePublicGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the public NAT gateways are located.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the public NAT gateway.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the public NAT gateway.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the public NAT gateway.",
+			},
+			"spec": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The specification of the public NAT gateway.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the VPC to which the public NAT gateway belongs.",
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The network ID of the downstream interface (the next hop of the DVR) " +
+					"of the public NAT gateway.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The current status of the public NAT gateway.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the public NAT gateway.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key/value pairs which the public NAT gateways must have.",
+			},
+			"gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        publicGatewaySchema(),
+				Description: "The list of the public NAT gateways.",
+			},
+		},
+	}
+}
+
+func publicGatewaySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the public NAT gateway.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the public NAT gateway.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the public NAT gateway.",
+			},
+			"spec": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The specification of the public NAT gateway.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VPC to which the public NAT gateway belongs.",
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The network ID of the downstream interface (the next hop of the DVR) " +
+					"of the public NAT gateway.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the public NAT gateway.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the public NAT gateway.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the public NAT gateway.",
+			},
+			"tags": common.TagsComputedSchema(),
+		},
+	}
+}
+
+func dataSourcePublicGatewaysRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+	networkClient, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	listOpts := gateways.ListOpts{
+		ID:                  d.Get("gateway_id").(string),
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Spec:                d.Get("spec").(string),
+		VpcId:               d.Get("vpc_id").(string),
+		InternalNetworkId:   d.Get("subnet_id").(string),
+		Status:              d.Get("status").(string),
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+	}
+	resp, err := gateways.List(client, listOpts)
+	if err != nil {
+		return diag.Errorf("error querying public NAT gateways: %s", err)
+	}
+
+	tagFilter := d.Get("tags").(map[string]interface{})
+	result := make([]map[string]interface{}, 0, len(resp))
+	for _, gateway := range resp {
+		var tagMap map[string]string
+		if gatewayTags, err := tags.Get(networkClient, "nat_gateways", gateway.ID).Extract(); err == nil {
+			tagMap = utils.DeleteIgnoredTags(cfg, utils.TagsToMap(gatewayTags.Tags)).(map[string]string)
+		} else {
+			log.Printf("[WARN] Error getting tags of the NAT gateway (%s): %s", gateway.ID, err)
+		}
+		if !utils.HasMapContains(tagMap, tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    gateway.ID,
+			"name":                  gateway.Name,
+			"description":           gateway.Description,
+			"spec":                  gateway.Spec,
+			"vpc_id":                gateway.RouterId,
+			"subnet_id":             gateway.InternalNetworkId,
+			"status":                gateway.Status,
+			"enterprise_project_id": gateway.EnterpriseProjectId,
+			"created_at":            gateway.CreatedAt,
+			"tags":                  tagMap,
+		})
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateways", result),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the public NAT gateways: %s", err)
+	}
+	return nil
+}
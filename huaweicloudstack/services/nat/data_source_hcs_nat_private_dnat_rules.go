@@ -0,0 +1,238 @@
+package nat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/dnats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+func DataSourcePrivateDnatRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrivateDnatRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the private DNAT rules are located.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private DNAT rule.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private NAT gateway to which the DNAT rules belong.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the transit IP of the private DNAT rules.",
+			},
+			"backend_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the backend instance of the private DNAT rules.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "any"}, false),
+				Description:  "The protocol type of the private DNAT rules.",
+			},
+			"backend_interface_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network interface ID of the backend instance of the private DNAT rules.",
+			},
+			"backend_private_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The private IP address of the backend instance of the private DNAT rules.",
+			},
+			"internal_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The port of the backend instance of the private DNAT rules.",
+			},
+			"transit_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The port of the transit IP of the private DNAT rules.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the private DNAT rules.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the private DNAT rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        privateDnatRuleSchema(),
+				Description: "The list of the private DNAT rules.",
+			},
+		},
+	}
+}
+
+func privateDnatRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private DNAT rule.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private NAT gateway to which the DNAT rule belongs.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the transit IP of the private DNAT rule.",
+			},
+			"backend_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backend instance.",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol type of the private DNAT rule.",
+			},
+			"backend_interface_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface ID of the backend instance.",
+			},
+			"backend_private_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private IP address of the backend instance.",
+			},
+			"internal_service_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port of the backend instance.",
+			},
+			"transit_service_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port of the transit IP.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the private DNAT rule.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the private DNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private DNAT rule.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private DNAT rule.",
+			},
+		},
+	}
+}
+
+func dataSourcePrivateDnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	listOpts := dnats.ListOpts{
+		ID:                  d.Get("rule_id").(string),
+		GatewayId:           d.Get("gateway_id").(string),
+		TransitIpId:         d.Get("transit_ip_id").(string),
+		Type:                d.Get("backend_type").(string),
+		Protocol:            d.Get("protocol").(string),
+		NetworkInterfaceId:  d.Get("backend_interface_id").(string),
+		PrivateIpAddress:    d.Get("backend_private_ip").(string),
+		InternalServicePort: buildPrivateDnatRulePort(d, "internal_service_port"),
+		TransitServicePort:  buildPrivateDnatRulePort(d, "transit_service_port"),
+		Description:         d.Get("description").(string),
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+	}
+	resp, err := dnats.List(client, listOpts)
+	if err != nil {
+		return diag.Errorf("error querying private DNAT rules: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("rules", flattenPrivateDnatRules(resp)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the private DNAT rules: %s", err)
+	}
+	return nil
+}
+
+func flattenPrivateDnatRules(rules []dnats.Rule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"id":                    rule.ID,
+			"gateway_id":            rule.GatewayId,
+			"transit_ip_id":         rule.TransitIpId,
+			"backend_type":          rule.Type,
+			"protocol":              rule.Protocol,
+			"backend_interface_id":  rule.NetworkInterfaceId,
+			"backend_private_ip":    rule.PrivateIpAddress,
+			"internal_service_port": flattenPrivateDnatRulePort(rule.InternalServicePort),
+			"transit_service_port":  flattenPrivateDnatRulePort(rule.TransitServicePort),
+			"description":           rule.Description,
+			"enterprise_project_id": rule.EnterpriseProjectId,
+			"created_at":            rule.CreatedAt,
+			"updated_at":            rule.UpdatedAt,
+		}
+	}
+	return result
+}
+
+// flattenPrivateDnatRulePort returns 0 if the rule applies to all ports.
+func flattenPrivateDnatRulePort(port string) int {
+	if port == "" {
+		return 0
+	}
+	result, _ := strconv.Atoi(port)
+	return result
+}
@@ -0,0 +1,203 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/gateways"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func DataSourcePrivateGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrivateGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the private NAT gateways are located.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private NAT gateway.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the private NAT gateway.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the private NAT gateway.",
+			},
+			"spec": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The specification of the private NAT gateway.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The current status of the private NAT gateway.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the VPC to which the private NAT gateway belongs.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the subnet to which the private NAT gateway belongs.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the private NAT gateway.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key/value pairs which the private NAT gateways must have.",
+			},
+			"gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        privateGatewaySchema(),
+				Description: "The list of the private NAT gateways.",
+			},
+		},
+	}
+}
+
+func privateGatewaySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private NAT gateway.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the private NAT gateway.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the private NAT gateway.",
+			},
+			"spec": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The specification of the private NAT gateway.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the private NAT gateway.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VPC to which the private NAT gateway belongs.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the subnet to which the private NAT gateway belongs.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the private NAT gateway.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private NAT gateway.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private NAT gateway.",
+			},
+			"tags": common.TagsComputedSchema(),
+		},
+	}
+}
+
+func dataSourcePrivateGatewaysRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	listOpts := gateways.ListOpts{
+		ID:                  d.Get("gateway_id").(string),
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Spec:                d.Get("spec").(string),
+		Status:              d.Get("status").(string),
+		VpcId:               d.Get("vpc_id").(string),
+		SubnetId:            d.Get("subnet_id").(string),
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+	}
+	resp, err := gateways.List(client, listOpts)
+	if err != nil {
+		return diag.Errorf("error querying private NAT gateways: %s", err)
+	}
+
+	tagFilter := d.Get("tags").(map[string]interface{})
+	result := make([]map[string]interface{}, 0, len(resp))
+	for _, gateway := range resp {
+		tagMap := utils.DeleteIgnoredTags(cfg, utils.TagsToMap(gateway.Tags)).(map[string]string)
+		if !utils.HasMapContains(tagMap, tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    gateway.ID,
+			"name":                  gateway.Name,
+			"description":           gateway.Description,
+			"spec":                  gateway.Spec,
+			"status":                gateway.Status,
+			"vpc_id":                flattenPrivateGatewayVpcId(gateway.DownLinkVpcs),
+			"subnet_id":             flattenPrivateGatewaySubnetId(gateway.DownLinkVpcs),
+			"enterprise_project_id": gateway.EnterpriseProjectId,
+			"created_at":            gateway.CreatedAt,
+			"updated_at":            gateway.UpdatedAt,
+			"tags":                  tagMap,
+		})
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateways", result),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the private NAT gateways: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/snats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+func DataSourcePrivateSnatRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrivateSnatRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the private SNAT rules are located.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private SNAT rule.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private NAT gateway to which the SNAT rules belong.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CIDR block of the match rule.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subnet ID of the match rule.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the transit IP associated with the private SNAT rules.",
+			},
+			"transit_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IP address of the transit IP associated with the private SNAT rules.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the private SNAT rules.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the private SNAT rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        privateSnatRuleSchema(),
+				Description: "The list of the private SNAT rules.",
+			},
+		},
+	}
+}
+
+func privateSnatRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private SNAT rule.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private NAT gateway to which the SNAT rule belongs.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR block of the match rule.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnet ID of the match rule.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the transit IP associated with the private SNAT rule.",
+			},
+			"transit_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the transit IP associated with the private SNAT rule.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the private SNAT rule.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the private SNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private SNAT rule.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private SNAT rule.",
+			},
+		},
+	}
+}
+
+func dataSourcePrivateSnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	listOpts := snats.ListOpts{
+		ID:                  d.Get("rule_id").(string),
+		GatewayId:           d.Get("gateway_id").(string),
+		Cidr:                d.Get("cidr").(string),
+		SubnetId:            d.Get("subnet_id").(string),
+		TransitIpId:         d.Get("transit_ip_id").(string),
+		TransitIpAddress:    d.Get("transit_ip_address").(string),
+		Description:         d.Get("description").(string),
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+	}
+	resp, err := snats.List(client, listOpts)
+	if err != nil {
+		return diag.Errorf("error querying private SNAT rules: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("rules", flattenPrivateSnatRules(resp)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the private SNAT rules: %s", err)
+	}
+	return nil
+}
+
+func flattenPrivateSnatRules(rules []snats.Rule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		transitIpId, transitIpAddress := flattenPrivateSnatRuleTransitIp(rule.TransitIpAssociations)
+		result[i] = map[string]interface{}{
+			"id":                    rule.ID,
+			"gateway_id":            rule.GatewayId,
+			"cidr":                  rule.Cidr,
+			"subnet_id":             rule.SubnetId,
+			"transit_ip_id":         transitIpId,
+			"transit_ip_address":    transitIpAddress,
+			"description":           rule.Description,
+			"enterprise_project_id": rule.EnterpriseProjectId,
+			"created_at":            rule.CreatedAt,
+			"updated_at":            rule.UpdatedAt,
+		}
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/transitips"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func DataSourcePrivateTransitIps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrivateTransitIpsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the transit IPs are located.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the transit IP.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IP address of the transit IP.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the private NAT gateway to which the transit IP belongs.",
+			},
+			"network_interface_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network interface ID of the transit IP.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the transit subnet to which the transit IP belongs.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the transit IP.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key/value pairs which the transit IPs must have.",
+			},
+			"transit_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        privateTransitIpSchema(),
+				Description: "The list of the transit IPs.",
+			},
+		},
+	}
+}
+
+func privateTransitIpSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the transit IP.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the transit IP.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private NAT gateway to which the transit IP belongs.",
+			},
+			"network_interface_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface ID of the transit IP.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the transit subnet to which the transit IP belongs.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the transit IP.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the transit IP.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the transit IP.",
+			},
+			"tags": common.TagsComputedSchema(),
+		},
+	}
+}
+
+func dataSourcePrivateTransitIpsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	listOpts := transitips.ListOpts{
+		ID:                  d.Get("transit_ip_id").(string),
+		IpAddress:           d.Get("ip_address").(string),
+		GatewayId:           d.Get("gateway_id").(string),
+		NetworkInterfaceId:  d.Get("network_interface_id").(string),
+		SubnetId:            d.Get("subnet_id").(string),
+		EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+	}
+	resp, err := transitips.List(client, listOpts)
+	if err != nil {
+		return diag.Errorf("error querying transit IPs: %s", err)
+	}
+
+	tagFilter := d.Get("tags").(map[string]interface{})
+	result := make([]map[string]interface{}, 0, len(resp))
+	for _, transitIp := range resp {
+		tagMap := utils.DeleteIgnoredTags(cfg, utils.TagsToMap(transitIp.Tags)).(map[string]string)
+		if !utils.HasMapContains(tagMap, tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    transitIp.ID,
+			"ip_address":            transitIp.IpAddress,
+			"gateway_id":            transitIp.GatewayId,
+			"network_interface_id":  transitIp.NetworkInterfaceId,
+			"subnet_id":             transitIp.SubnetId,
+			"enterprise_project_id": transitIp.EnterpriseProjectId,
+			"created_at":            transitIp.CreatedAt,
+			"updated_at":            transitIp.UpdatedAt,
+			"tags":                  tagMap,
+		})
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("transit_ips", result),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the transit IPs: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package nat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v2/snats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+func DataSourcePublicSnatRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePublicSnatRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the SNAT rules are located.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the SNAT rule.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the NAT gateway to which the SNAT rules belong.",
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the floating IP connected by the SNAT rules.",
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The floating IP address connected by the SNAT rules.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network ID of the subnet connected by the SNAT rules.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CIDR block connected by the SNAT rules.",
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"0", "1"}, false),
+				Description:  "The resource type of the SNAT rules.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status of the SNAT rules.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the SNAT rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        publicSnatRuleSchema(),
+				Description: "The list of the SNAT rules.",
+			},
+		},
+	}
+}
+
+func publicSnatRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the SNAT rule.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the NAT gateway to which the SNAT rule belongs.",
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IDs (separated by commas) of the floating IPs connected by the SNAT rule.",
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The floating IP addresses (separated by commas) connected by the SNAT rule.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network ID of the subnet connected by the SNAT rule.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR block connected by the SNAT rule.",
+			},
+			"source_type": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The resource type of the SNAT rule.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the SNAT rule.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the SNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the SNAT rule.",
+			},
+		},
+	}
+}
+
+func buildPublicSnatRulesListOpts(d *schema.ResourceData) snats.ListOpts {
+	opts := snats.ListOpts{
+		ID:                d.Get("rule_id").(string),
+		GatewayId:         d.Get("nat_gateway_id").(string),
+		FloatingIpId:      d.Get("floating_ip_id").(string),
+		FloatingIpAddress: d.Get("floating_ip_address").(string),
+		NetworkId:         d.Get("subnet_id").(string),
+		Cidr:              d.Get("cidr").(string),
+		Status:            d.Get("status").(string),
+		Description:       d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("source_type"); ok {
+		// The value has been validated by the schema.
+		sourceType, _ := strconv.Atoi(v.(string))
+		opts.SourceType = &sourceType
+	}
+	return opts
+}
+
+func dataSourcePublicSnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	resp, err := snats.List(client, buildPublicSnatRulesListOpts(d))
+	if err != nil {
+		return diag.Errorf("error querying SNAT rules: %s", err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("rules", flattenPublicSnatRules(resp)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving data source fields of the SNAT rules: %s", err)
+	}
+	return nil
+}
+
+func flattenPublicSnatRules(rules []snats.Rule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"id":                  rule.ID,
+			"nat_gateway_id":      rule.GatewayId,
+			"floating_ip_id":      rule.FloatingIpId,
+			"floating_ip_address": rule.FloatingIpAddress,
+			"subnet_id":           rule.NetworkId,
+			"cidr":                rule.Cidr,
+			"source_type":         rule.SourceType,
+			"status":              rule.Status,
+			"description":         rule.Description,
+			"created_at":          rule.CreatedAt,
+		}
+	}
+	return result
+}
@@ -50,6 +50,8 @@ func ResourcePublicGateway() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:        schema.TypeString,
@@ -97,10 +99,13 @@ func ResourcePublicGateway() *schema.Resource {
 			},
 			"enterprise_project_id": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
+				ForceNew:    true,
 				Description: "The enterprise project ID of the NAT gateway.",
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -165,12 +170,12 @@ func resourcePublicGatewayCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	if gatewayTags, ok := d.GetOk("tags"); ok {
+	if gatewayTags := utils.GetResourceTags(d, cfg); len(gatewayTags) > 0 {
 		networkClient, err := cfg.NetworkingV2Client(region)
 		if err != nil {
 			return diag.Errorf("error creating VPC v2.0 client: %s", err)
 		}
-		taglist := utils.ExpandResourceTags(gatewayTags.(map[string]interface{}))
+		taglist := utils.ExpandResourceTags(gatewayTags)
 		err = tags.Create(networkClient, "nat_gateways", d.Id(), taglist).ExtractErr()
 		if err != nil {
 			return diag.Errorf("error setting tags to the NAT gateway: %s", err)
@@ -211,7 +216,10 @@ func resourcePublicGatewayRead(_ context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		log.Printf("[WARN] Error getting gateway tags: %s", err)
 	} else {
-		mErr = multierror.Append(mErr, d.Set("tags", utils.TagsToMap(gatewayTags.Tags)))
+		tagMap := utils.TagsToMap(gatewayTags.Tags)
+		mErr = multierror.Append(mErr,
+			utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		)
 	}
 
 	if err = mErr.ErrorOrNil(); err != nil {
@@ -227,7 +235,7 @@ func resourcePublicGatewayUpdate(ctx context.Context, d *schema.ResourceData, me
 		gatewayId = d.Id()
 	)
 
-	if d.HasChangeExcept("tags") {
+	if d.HasChangesExcept("tags", "tags_all") {
 		client, err := cfg.NatGatewayClient(region)
 		if err != nil {
 			return diag.Errorf("error creating NAT v2 client: %s", err)
@@ -255,12 +263,12 @@ func resourcePublicGatewayUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		networkClient, err := cfg.NetworkingV2Client(region)
 		if err != nil {
 			return diag.Errorf("error creating VPC v2.0 client: %s", err)
 		}
-		err = utils.UpdateResourceTags(networkClient, d, "nat_gateways", gatewayId)
+		err = utils.UpdateResourceTags(networkClient, d, cfg, "nat_gateways", gatewayId)
 		if err != nil {
 			return diag.Errorf("error updating tags of the NAT gateway: %s", err)
 		}
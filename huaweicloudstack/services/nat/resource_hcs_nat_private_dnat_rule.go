@@ -0,0 +1,229 @@
+package nat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/dnats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func ResourcePrivateDnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateDnatRuleCreate,
+		ReadContext:   resourcePrivateDnatRuleRead,
+		UpdateContext: resourcePrivateDnatRuleUpdate,
+		DeleteContext: resourcePrivateDnatRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the private DNAT rule is located.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the private NAT gateway to which the DNAT rule belongs.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the transit IP for private NAT.",
+			},
+			"transit_service_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				RequiredWith: []string{"internal_service_port"},
+				Description:  "The port of the transit IP.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "any"}, false),
+				Description:  "The protocol type of the private DNAT rule.",
+			},
+			"backend_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"backend_private_ip"},
+				Description:  "The network interface ID of the backend instance.",
+			},
+			"backend_private_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "The private IP address of the backend instance.",
+			},
+			"internal_service_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				RequiredWith: []string{"transit_service_port"},
+				Description:  "The port of the backend instance.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "The description of the private DNAT rule.",
+			},
+			"backend_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backend instance.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the private DNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private DNAT rule.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private DNAT rule.",
+			},
+		},
+	}
+}
+
+// The ports of the private DNAT rule are strings in the API, and the empty string means all ports.
+// The port is checked by the raw config, because GetOk can not tell the port 0 from the omitted one.
+func buildPrivateDnatRulePort(d *schema.ResourceData, key string) string {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return ""
+	}
+	return strconv.Itoa(d.Get(key).(int))
+}
+
+func resourcePrivateDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	opts := dnats.CreateOpts{
+		GatewayId:           d.Get("gateway_id").(string),
+		TransitIpId:         d.Get("transit_ip_id").(string),
+		Description:         d.Get("description").(string),
+		NetworkInterfaceId:  d.Get("backend_interface_id").(string),
+		Protocol:            d.Get("protocol").(string),
+		PrivateIpAddress:    d.Get("backend_private_ip").(string),
+		InternalServicePort: buildPrivateDnatRulePort(d, "internal_service_port"),
+		TransitServicePort:  buildPrivateDnatRulePort(d, "transit_service_port"),
+	}
+	resp, err := dnats.Create(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating private DNAT rule: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateDnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	resp, err := dnats.Get(client, ruleId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private DNAT rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", resp.GatewayId),
+		d.Set("transit_ip_id", resp.TransitIpId),
+		d.Set("transit_service_port", flattenPrivateDnatRulePort(resp.TransitServicePort)),
+		d.Set("protocol", resp.Protocol),
+		d.Set("backend_interface_id", resp.NetworkInterfaceId),
+		d.Set("backend_private_ip", resp.PrivateIpAddress),
+		d.Set("internal_service_port", flattenPrivateDnatRulePort(resp.InternalServicePort)),
+		d.Set("description", resp.Description),
+		d.Set("backend_type", resp.Type),
+		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private DNAT rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePrivateDnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	opts := dnats.UpdateOpts{
+		TransitIpId:         d.Get("transit_ip_id").(string),
+		Description:         utils.String(d.Get("description").(string)),
+		Protocol:            d.Get("protocol").(string),
+		InternalServicePort: buildPrivateDnatRulePort(d, "internal_service_port"),
+		TransitServicePort:  buildPrivateDnatRulePort(d, "transit_service_port"),
+	}
+	if d.HasChanges("backend_interface_id", "backend_private_ip") {
+		// Only one of the network interface ID and the private IP address can be specified.
+		opts.NetworkInterfaceId = d.Get("backend_interface_id").(string)
+		opts.PrivateIpAddress = d.Get("backend_private_ip").(string)
+		if opts.NetworkInterfaceId != "" && opts.PrivateIpAddress != "" {
+			if d.HasChange("backend_interface_id") {
+				opts.PrivateIpAddress = ""
+			} else {
+				opts.NetworkInterfaceId = ""
+			}
+		}
+	}
+	_, err = dnats.Update(client, ruleId, opts)
+	if err != nil {
+		return diag.Errorf("error updating private DNAT rule (%s): %s", ruleId, err)
+	}
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateDnatRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	if err = dnats.Delete(client, ruleId); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private DNAT rule")
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/gateways"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+type (
+	PrivateSpecType string
+)
+
+const (
+	PrivateSpecTypeSmall      PrivateSpecType = "Small"
+	PrivateSpecTypeMedium     PrivateSpecType = "Medium"
+	PrivateSpecTypeLarge      PrivateSpecType = "Large"
+	PrivateSpecTypeExtraLarge PrivateSpecType = "Extra-Large"
+)
+
+func ResourcePrivateGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateGatewayCreate,
+		ReadContext:   resourcePrivateGatewayRead,
+		UpdateContext: resourcePrivateGatewayUpdate,
+		DeleteContext: resourcePrivateGatewayDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: config.SetTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the private NAT gateway is located.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the subnet to which the private NAT gateway belongs.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\w-]*$`),
+						"Only letters, digits, hyphens (-) and underscores (_) are allowed."),
+					validation.StringLenBetween(1, 64),
+				),
+				Description: "The private NAT gateway name.",
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(PrivateSpecTypeSmall),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(PrivateSpecTypeSmall),
+					string(PrivateSpecTypeMedium),
+					string(PrivateSpecTypeLarge),
+					string(PrivateSpecTypeExtraLarge),
+				}, false),
+				Description: "The specification of the private NAT gateway.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "The description of the private NAT gateway.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The enterprise project ID of the private NAT gateway.",
+			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VPC to which the private NAT gateway belongs.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the private NAT gateway.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private NAT gateway.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private NAT gateway.",
+			},
+		},
+	}
+}
+
+func privateGatewayStateRefreshFunc(client *golangsdk.ServiceClient, gatewayId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := gateways.Get(client, gatewayId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return resp, "COMPLETED", nil
+			}
+			return resp, "", err
+		}
+
+		if utils.StrSliceContains([]string{"INACTIVE", "FROZEN"}, resp.Status) {
+			return resp, "", fmt.Errorf("unexpect status (%s)", resp.Status)
+		}
+		if utils.StrSliceContains(targets, resp.Status) {
+			return resp, "COMPLETED", nil
+		}
+		return resp, "PENDING", nil
+	}
+}
+
+func resourcePrivateGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	opts := gateways.CreateOpts{
+		Name: d.Get("name").(string),
+		DownLinkVpcs: []gateways.DownLinkVpc{
+			{
+				SubnetId: d.Get("subnet_id").(string),
+			},
+		},
+		Spec:                d.Get("spec").(string),
+		Description:         d.Get("description").(string),
+		EnterpriseProjectId: cfg.GetEnterpriseProjectID(d),
+		Tags:                utils.ExpandResourceTags(utils.GetResourceTags(d, cfg)),
+	}
+	resp, err := gateways.Create(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating private NAT gateway: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      privateGatewayStateRefreshFunc(client, d.Id(), []string{"ACTIVE"}),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePrivateGatewayRead(ctx, d, meta)
+}
+
+func resourcePrivateGatewayRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	gatewayId := d.Id()
+	resp, err := gateways.Get(client, gatewayId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT gateway")
+	}
+
+	tagMap := utils.TagsToMap(resp.Tags)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", flattenPrivateGatewaySubnetId(resp.DownLinkVpcs)),
+		d.Set("vpc_id", flattenPrivateGatewayVpcId(resp.DownLinkVpcs)),
+		d.Set("name", resp.Name),
+		d.Set("spec", resp.Spec),
+		d.Set("description", resp.Description),
+		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
+		utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		d.Set("status", resp.Status),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private NAT gateway fields: %s", err)
+	}
+	return nil
+}
+
+func flattenPrivateGatewaySubnetId(downLinkVpcs []gateways.DownLinkVpc) string {
+	if len(downLinkVpcs) < 1 {
+		return ""
+	}
+	return downLinkVpcs[0].SubnetId
+}
+
+func flattenPrivateGatewayVpcId(downLinkVpcs []gateways.DownLinkVpc) string {
+	if len(downLinkVpcs) < 1 {
+		return ""
+	}
+	return downLinkVpcs[0].VpcId
+}
+
+func resourcePrivateGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg       = config.GetHcsConfig(meta)
+		gatewayId = d.Id()
+	)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		opts := gateways.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Spec:        d.Get("spec").(string),
+			Description: utils.String(d.Get("description").(string)),
+		}
+		_, err = gateways.Update(client, gatewayId, opts)
+		if err != nil {
+			return diag.Errorf("error updating private NAT gateway (%s): %s", gatewayId, err)
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"PENDING"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      privateGatewayStateRefreshFunc(client, gatewayId, []string{"ACTIVE"}),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        5 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		err = utils.UpdateResourceTags(client, d, cfg, "private-nat-gateways", gatewayId)
+		if err != nil {
+			return diag.Errorf("error updating tags of the private NAT gateway (%s): %s", gatewayId, err)
+		}
+	}
+
+	return resourcePrivateGatewayRead(ctx, d, meta)
+}
+
+func resourcePrivateGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	gatewayId := d.Id()
+	if err = gateways.Delete(client, gatewayId); err != nil {
+		return diag.Errorf("error deleting private NAT gateway (%s): %s", gatewayId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      privateGatewayStateRefreshFunc(client, gatewayId, nil),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
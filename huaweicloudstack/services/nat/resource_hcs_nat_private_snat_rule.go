@@ -0,0 +1,187 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/snats"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func ResourcePrivateSnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateSnatRuleCreate,
+		ReadContext:   resourcePrivateSnatRuleRead,
+		UpdateContext: resourcePrivateSnatRuleUpdate,
+		DeleteContext: resourcePrivateSnatRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the private SNAT rule is located.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the private NAT gateway to which the SNAT rule belongs.",
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the transit IP associated with the private SNAT rule.",
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				ExactlyOneOf: []string{"subnet_id"},
+				Description:  "The CIDR block of the match rule.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The subnet ID of the match rule.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "The description of the private SNAT rule.",
+			},
+			"transit_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the transit IP associated with the private SNAT rule.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID of the private SNAT rule.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the private SNAT rule.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the private SNAT rule.",
+			},
+		},
+	}
+}
+
+func resourcePrivateSnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	opts := snats.CreateOpts{
+		GatewayId:    d.Get("gateway_id").(string),
+		TransitIpIds: []string{d.Get("transit_ip_id").(string)},
+		Cidr:         d.Get("cidr").(string),
+		SubnetId:     d.Get("subnet_id").(string),
+		Description:  d.Get("description").(string),
+	}
+	resp, err := snats.Create(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating private SNAT rule: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateSnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	resp, err := snats.Get(client, ruleId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private SNAT rule")
+	}
+
+	transitIpId, transitIpAddress := flattenPrivateSnatRuleTransitIp(resp.TransitIpAssociations)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", resp.GatewayId),
+		d.Set("transit_ip_id", transitIpId),
+		d.Set("cidr", resp.Cidr),
+		d.Set("subnet_id", resp.SubnetId),
+		d.Set("description", resp.Description),
+		d.Set("transit_ip_address", transitIpAddress),
+		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private SNAT rule fields: %s", err)
+	}
+	return nil
+}
+
+func flattenPrivateSnatRuleTransitIp(associations []snats.AssociatedTransitIp) (id, address string) {
+	if len(associations) < 1 {
+		return "", ""
+	}
+	return associations[0].ID, associations[0].Address
+}
+
+func resourcePrivateSnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	opts := snats.UpdateOpts{
+		Description: utils.String(d.Get("description").(string)),
+	}
+	if d.HasChange("transit_ip_id") {
+		opts.TransitIpIds = []string{d.Get("transit_ip_id").(string)}
+	}
+	_, err = snats.Update(client, ruleId, opts)
+	if err != nil {
+		return diag.Errorf("error updating private SNAT rule (%s): %s", ruleId, err)
+	}
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateSnatRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	ruleId := d.Id()
+	if err = snats.Delete(client, ruleId); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private SNAT rule")
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package nat
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/nat/v3/transitips"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func ResourcePrivateTransitIp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateTransitIpCreate,
+		ReadContext:   resourcePrivateTransitIpRead,
+		UpdateContext: resourcePrivateTransitIpUpdate,
+		DeleteContext: resourcePrivateTransitIpDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: config.SetTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the transit IP is located.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the transit subnet to which the transit IP belongs.",
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "The IP address of the transit IP.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The enterprise project ID of the transit IP.",
+			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
+			"network_interface_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface ID of the transit IP.",
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private NAT gateway to which the transit IP belongs.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the transit IP.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest update time of the transit IP.",
+			},
+		},
+	}
+}
+
+func resourcePrivateTransitIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	opts := transitips.CreateOpts{
+		SubnetId:            d.Get("subnet_id").(string),
+		IpAddress:           d.Get("ip_address").(string),
+		EnterpriseProjectId: cfg.GetEnterpriseProjectID(d),
+		Tags:                utils.ExpandResourceTags(utils.GetResourceTags(d, cfg)),
+	}
+	resp, err := transitips.Create(client, opts)
+	if err != nil {
+		return diag.Errorf("error creating transit IP: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	return resourcePrivateTransitIpRead(ctx, d, meta)
+}
+
+func resourcePrivateTransitIpRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	transitIpId := d.Id()
+	resp, err := transitips.Get(client, transitIpId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "transit IP")
+	}
+
+	tagMap := utils.TagsToMap(resp.Tags)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", resp.SubnetId),
+		d.Set("ip_address", resp.IpAddress),
+		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
+		utils.SetTagsAndTagsAll(d, cfg, tagMap),
+		d.Set("network_interface_id", resp.NetworkInterfaceId),
+		d.Set("gateway_id", resp.GatewayId),
+		d.Set("created_at", resp.CreatedAt),
+		d.Set("updated_at", resp.UpdatedAt),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving transit IP fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePrivateTransitIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	transitIpId := d.Id()
+	err = utils.UpdateResourceTags(client, d, cfg, "transit-ips", transitIpId)
+	if err != nil {
+		return diag.Errorf("error updating tags of the transit IP (%s): %s", transitIpId, err)
+	}
+
+	return resourcePrivateTransitIpRead(ctx, d, meta)
+}
+
+func resourcePrivateTransitIpDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.NatV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	transitIpId := d.Id()
+	if err = transitips.Delete(client, transitIpId); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting transit IP")
+	}
+	return nil
+}
@@ -33,6 +33,8 @@ func ResourceObsBucket() *schema.Resource {
 			StateContext: resourceObsBucketImport,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -287,7 +289,8 @@ func ResourceObsBucket() *schema.Resource {
 				},
 			},
 
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -456,8 +459,8 @@ func resourceObsBucketUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
-		if err := resourceObsBucketTagsUpdate(obsClient, d); err != nil {
+	if d.HasChanges("tags", "tags_all") {
+		if err := resourceObsBucketTagsUpdate(obsClient, d, conf); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -617,7 +620,7 @@ func resourceObsBucketRead(_ context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// Read the tags
-	if err := setObsBucketTags(obsClient, d); err != nil {
+	if err := setObsBucketTags(obsClient, d, conf); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -666,9 +669,23 @@ func resourceObsBucketDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData, conf *config.HcsConfig) error {
 	bucket := d.Get("bucket").(string)
-	tagMap := d.Get("tags").(map[string]interface{})
+
+	// all tags of the bucket are replaced, so the ignored tags are read back and kept
+	remoteTags := make(map[string]string)
+	output, err := obsClient.GetBucketTagging(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); !ok || obsError.Code != "NoSuchTagSet" {
+			return getObsError("Error getting tags of OBS bucket", bucket, err)
+		}
+	} else {
+		for _, tag := range output.Tags {
+			remoteTags[tag.Key] = tag.Value
+		}
+	}
+
+	tagMap := utils.MergeIgnoredTags(conf, utils.GetResourceTags(d, conf), remoteTags)
 	var tagList []obs.Tag
 	for k, v := range tagMap {
 		tag := obs.Tag{
@@ -683,7 +700,7 @@ func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceDat
 	req.Tags = tagList
 	log.Printf("[DEBUG] set tags of OBS bucket %s: %#v", bucket, req)
 
-	_, err := obsClient.SetBucketTagging(req)
+	_, err = obsClient.SetBucketTagging(req)
 	if err != nil {
 		return getObsError("Error updating tags of OBS bucket", bucket, err)
 	}
@@ -1561,13 +1578,17 @@ func setObsBucketCorsRules(obsClient *obs.ObsClient, d *schema.ResourceData) err
 	return nil
 }
 
-func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData, conf *config.HcsConfig) error {
 	bucket := d.Id()
 	output, err := obsClient.GetBucketTagging(bucket)
 	if err != nil {
 		if obsError, ok := err.(obs.ObsError); ok {
 			if obsError.Code == "NoSuchTagSet" {
-				if err := d.Set("tags", nil); err != nil {
+				mErr := multierror.Append(nil,
+					d.Set("tags", nil),
+					d.Set("tags_all", nil),
+				)
+				if err := mErr.ErrorOrNil(); err != nil {
 					return fmt.Errorf("error saving tags of OBS bucket %s: %s", bucket, err)
 				}
 				return nil
@@ -1579,10 +1600,12 @@ func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 
 	tagMap := make(map[string]string)
 	for _, tag := range output.Tags {
-		tagMap[tag.Key] = tag.Value
+		if !utils.IsIgnoredTagKey(conf, tag.Key) {
+			tagMap[tag.Key] = tag.Value
+		}
 	}
 	log.Printf("[DEBUG] getting tags of OBS bucket %s: %#v", bucket, tagMap)
-	if err := d.Set("tags", tagMap); err != nil {
+	if err := utils.SetTagsAndTagsAll(d, conf, tagMap); err != nil {
 		return fmt.Errorf("error saving tags of OBS bucket %s: %s", bucket, err)
 	}
 	return nil
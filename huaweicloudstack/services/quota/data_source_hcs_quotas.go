@@ -0,0 +1,128 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// @API ECS GET /v1/{project_id}/cloudservers/limits
+// @API VPC GET /v1/{project_id}/quotas
+// @API EVS GET /v2/{project_id}/os-quota-sets/{project_id}
+// @API ELB GET /v3/{project_id}/elb/quotas/details
+func DataSourceQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region in which to query the quotas.`,
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(Services, false),
+				},
+				Description: `The services of the quotas, defaults to all supported services.`,
+			},
+			"quotas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The list of quotas.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The service of the quota.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The resource type of the quota.`,
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The maximum number of the resources, -1 means unlimited.`,
+						},
+						"used": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of the used resources.`,
+						},
+						"remaining": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of the resources which can be created, -1 means unlimited.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenQuotas(quotas []Quota) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(quotas))
+	for _, v := range quotas {
+		remaining := -1
+		if v.Limit >= 0 {
+			remaining = v.Limit - v.Used
+			if remaining < 0 {
+				remaining = 0
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"service":   v.Service,
+			"type":      v.Type,
+			"limit":     v.Limit,
+			"used":      v.Used,
+			"remaining": remaining,
+		})
+	}
+	return result
+}
+
+func dataSourceQuotasRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+
+	services := utils.ExpandToStringList(d.Get("services").([]interface{}))
+	if len(services) == 0 {
+		services = Services
+	}
+
+	var allQuotas []Quota
+	for _, service := range services {
+		quotas, err := ListQuotas(cfg, region, service)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		allQuotas = append(allQuotas, quotas...)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("quotas", flattenQuotas(allQuotas)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,94 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+// AmountFunc returns the amount of the quota which is consumed by the planned resource.
+type AmountFunc func(d *schema.ResourceDiff, cfg *config.HcsConfig, region string) (int, error)
+
+// plannedAmounts are the amounts of the quotas consumed by the resources planned by a provider instance.
+type plannedAmounts struct {
+	lock    sync.Mutex
+	amounts map[string]int
+}
+
+// PrecheckDiff returns a CustomizeDiffFunc which adds the amount of the quota consumed by the planned creation to the
+// amounts of the other resources planned by the provider, and fails the plan if the remaining quota is insufficient.
+// It only works if quota_precheck is enabled, and a nil amount means a resource consumes one quota.
+// The quotas are queried once per provider instance, and the failed queries are logged without failing the plan.
+func PrecheckDiff(service, quotaType string, amount AmountFunc) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		cfg := config.GetHcsConfig(meta)
+		// the diff of a replacement is customized again without the state, so it is counted as a creation, including
+		// the create_before_destroy replacements
+		if !cfg.QuotaPrecheck || d.Id() != "" {
+			return nil
+		}
+
+		region := cfg.Region
+		if v, ok := d.GetOk("region"); ok {
+			region = v.(string)
+		}
+
+		count := 1
+		if amount != nil {
+			var err error
+			if count, err = amount(d, cfg, region); err != nil {
+				log.Printf("[WARN] unable to get the planned amount of the %s %s quota, skipping the precheck: %s",
+					service, quotaType, err)
+				return nil
+			}
+		}
+		if count <= 0 {
+			return nil
+		}
+
+		result, err := cfg.PlanCache.Get(fmt.Sprintf("quota/%s/%s", service, region), func() (interface{}, error) {
+			return ListQuotas(cfg, region, service)
+		})
+		if err != nil {
+			log.Printf("[WARN] unable to query the quotas, skipping the precheck: %s", err)
+			return nil
+		}
+
+		var quota *Quota
+		for _, v := range result.([]Quota) {
+			if v.Type == quotaType {
+				quota = &v
+				break
+			}
+		}
+		if quota == nil {
+			log.Printf("[WARN] the %s %s quota is not found in %s, skipping the precheck", service, quotaType, region)
+			return nil
+		}
+		return addPlannedAmount(cfg, region, quota, count)
+	}
+}
+
+func addPlannedAmount(cfg *config.HcsConfig, region string, quota *Quota, count int) error {
+	// the cache always returns the same object, so the amounts are shared by the resources of a provider instance
+	result, _ := cfg.PlanCache.Get("quota/planned", func() (interface{}, error) {
+		return &plannedAmounts{amounts: make(map[string]int)}, nil
+	})
+	planned := result.(*plannedAmounts)
+
+	key := fmt.Sprintf("%s/%s/%s", region, quota.Service, quota.Type)
+	planned.lock.Lock()
+	defer planned.lock.Unlock()
+
+	planned.amounts[key] += count
+	if quota.Limit >= 0 && quota.Used+planned.amounts[key] > quota.Limit {
+		return fmt.Errorf("the %s %s quota is insufficient in %s, the limit is %d, %d are used and %d are planned",
+			quota.Service, quota.Type, region, quota.Limit, quota.Used, planned.amounts[key])
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package quota
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+func TestAddPlannedAmount(t *testing.T) {
+	cfg := &config.HcsConfig{PlanCache: config.NewPlanCache()}
+	instances := &Quota{Service: "ecs", Type: "instances", Limit: 5, Used: 2}
+	cores := &Quota{Service: "ecs", Type: "cores", Limit: -1, Used: 100}
+
+	// the amounts of the resources are accumulated by the quota and region
+	th.AssertNoErr(t, addPlannedAmount(cfg, "region-1", instances, 1))
+	th.AssertNoErr(t, addPlannedAmount(cfg, "region-1", instances, 2))
+	th.AssertNoErr(t, addPlannedAmount(cfg, "region-2", instances, 3))
+
+	err := addPlannedAmount(cfg, "region-1", instances, 1)
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, "the ecs instances quota is insufficient in region-1, the limit is 5, 2 are used and 4 are planned",
+		err.Error())
+
+	// the unlimited quota is never exceeded
+	for i := 0; i < 3; i++ {
+		th.AssertNoErr(t, addPlannedAmount(cfg, "region-1", cores, 1000))
+	}
+
+	// the amounts are not shared by the provider instances
+	th.AssertNoErr(t, addPlannedAmount(&config.HcsConfig{PlanCache: config.NewPlanCache()}, "region-1", instances, 3))
+}
+
+func testPrecheckResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func TestPrecheckDiff(t *testing.T) {
+	cfg := &config.HcsConfig{PlanCache: config.NewPlanCache(), QuotaPrecheck: true}
+	cfg.Region = "region-1"
+	// the quotas of the region are cached, so they are not queried
+	_, err := cfg.PlanCache.Get("quota/ecs/region-1", func() (interface{}, error) {
+		return []Quota{{Service: "ecs", Type: "instances", Limit: 3, Used: 1}}, nil
+	})
+	th.AssertNoErr(t, err)
+
+	resource := testPrecheckResource()
+	resource.CustomizeDiff = PrecheckDiff("ecs", "instances", nil)
+	plan := func(state *terraform.InstanceState, raw map[string]interface{}) error {
+		_, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), cfg)
+		return err
+	}
+	state := &terraform.InstanceState{
+		ID:         "resource-id",
+		Attributes: map[string]string{"id": "resource-id", "name": "test", "flavor": "s6.large.2"},
+	}
+
+	// the updates in place are not counted, but the replacements are
+	th.AssertNoErr(t, plan(state, map[string]interface{}{"name": "update", "flavor": "s6.large.2"}))
+	th.AssertNoErr(t, plan(nil, map[string]interface{}{"name": "test-1"}))
+	th.AssertNoErr(t, plan(state, map[string]interface{}{"name": "test", "flavor": "s6.xlarge.2"}))
+
+	err = plan(nil, map[string]interface{}{"name": "test-2"})
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "the ecs instances quota is insufficient in region-1"))
+}
@@ -0,0 +1,167 @@
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// Quota is the limit and usage of a type of resources, the limit is -1 if the resources are unlimited.
+type Quota struct {
+	Service string
+	Type    string
+	Limit   int
+	Used    int
+}
+
+type quotaLister struct {
+	// the service catalog of the client
+	catalog string
+	list    func(client *golangsdk.ServiceClient) ([]Quota, error)
+}
+
+var quotaListers = map[string]quotaLister{
+	"ecs": {catalog: "ecs", list: listEcsQuotas},
+	"vpc": {catalog: "vpc", list: listVpcQuotas},
+	"evs": {catalog: "evs", list: listEvsQuotas},
+	"elb": {catalog: "elbv3", list: listElbQuotas},
+}
+
+// Services are the services which support querying the quotas.
+var Services = []string{"ecs", "vpc", "evs", "elb"}
+
+// ListQuotas returns the quotas of the service in the region.
+func ListQuotas(cfg *config.HcsConfig, region, service string) ([]Quota, error) {
+	lister, ok := quotaListers[service]
+	if !ok {
+		return nil, fmt.Errorf("the quotas of service %s are not supported", service)
+	}
+
+	client, err := cfg.NewServiceClient(lister.catalog, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s client: %s", strings.ToUpper(service), err)
+	}
+	quotas, err := lister.list(client)
+	if err != nil {
+		return nil, fmt.Errorf("error querying the %s quotas: %s", strings.ToUpper(service), err)
+	}
+	for i := range quotas {
+		quotas[i].Service = service
+	}
+	return quotas, nil
+}
+
+func getQuotaResponse(client *golangsdk.ServiceClient, httpUrl string) (interface{}, error) {
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/json;charset=UTF-8",
+		},
+	}
+	requestResp, err := client.Request("GET", getPath, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(requestResp)
+}
+
+// ecsLimits maps the quota types to the limit and usage fields of the absolute limits.
+var ecsLimits = []struct {
+	quotaType string
+	limit     string
+	used      string
+}{
+	{"instances", "maxTotalInstances", "totalInstancesUsed"},
+	{"cores", "maxTotalCores", "totalCoresUsed"},
+	{"ram", "maxTotalRAMSize", "totalRAMUsed"},
+	{"server_groups", "maxServerGroups", "totalServerGroupsUsed"},
+}
+
+// @API ECS GET /v1/{project_id}/cloudservers/limits
+func listEcsQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	respBody, err := getQuotaResponse(client, "v1/{project_id}/cloudservers/limits")
+	if err != nil {
+		return nil, err
+	}
+
+	absolute := utils.PathSearch("absolute", respBody, nil)
+	quotas := make([]Quota, 0, len(ecsLimits))
+	for _, v := range ecsLimits {
+		quotas = append(quotas, Quota{
+			Type:  v.quotaType,
+			Limit: int(utils.PathSearch(v.limit, absolute, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch(v.used, absolute, float64(0)).(float64)),
+		})
+	}
+	return quotas, nil
+}
+
+// @API VPC GET /v1/{project_id}/quotas
+func listVpcQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	respBody, err := getQuotaResponse(client, "v1/{project_id}/quotas")
+	if err != nil {
+		return nil, err
+	}
+
+	resources := utils.PathSearch("quotas.resources", respBody, make([]interface{}, 0)).([]interface{})
+	quotas := make([]Quota, 0, len(resources))
+	for _, v := range resources {
+		quotas = append(quotas, Quota{
+			Type:  utils.PathSearch("type", v, "").(string),
+			Limit: int(utils.PathSearch("quota", v, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch("used", v, float64(0)).(float64)),
+		})
+	}
+	return quotas, nil
+}
+
+// @API EVS GET /v2/{project_id}/os-quota-sets/{project_id}
+func listEvsQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	respBody, err := getQuotaResponse(client, "v2/{project_id}/os-quota-sets/{project_id}?usage=True")
+	if err != nil {
+		return nil, err
+	}
+
+	quotaSet, _ := utils.PathSearch("quota_set", respBody, nil).(map[string]interface{})
+	quotas := make([]Quota, 0, len(quotaSet))
+	// the quota set contains the ID of the project, and the usage of each type is an object
+	for quotaType, v := range quotaSet {
+		if _, ok := v.(map[string]interface{}); !ok {
+			continue
+		}
+		quotas = append(quotas, Quota{
+			Type:  quotaType,
+			Limit: int(utils.PathSearch("limit", v, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch("in_use", v, float64(0)).(float64)),
+		})
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Type < quotas[j].Type })
+	return quotas, nil
+}
+
+// @API ELB GET /v3/{project_id}/elb/quotas/details
+func listElbQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	respBody, err := getQuotaResponse(client, "v3/{project_id}/elb/quotas/details")
+	if err != nil {
+		return nil, err
+	}
+
+	quotaInfo := utils.PathSearch("quotas", respBody, make([]interface{}, 0)).([]interface{})
+	quotas := make([]Quota, 0, len(quotaInfo))
+	for _, v := range quotaInfo {
+		quotas = append(quotas, Quota{
+			Type:  utils.PathSearch("quota_key", v, "").(string),
+			Limit: int(utils.PathSearch("quota_limit", v, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch("used", v, float64(0)).(float64)),
+		})
+	}
+	return quotas, nil
+}
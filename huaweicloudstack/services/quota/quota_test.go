@@ -0,0 +1,121 @@
+package quota
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	th "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/testhelper"
+)
+
+const testProjectID = "project-id"
+
+func newTestServiceClient() *golangsdk.ServiceClient {
+	return &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: testProjectID},
+		Endpoint:       th.Endpoint(),
+	}
+}
+
+func handleTestQuotas(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, body)
+	})
+}
+
+func TestListEcsQuotas(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	// the server group limits are not returned, so the quota is unlimited
+	handleTestQuotas(t, "/v1/project-id/cloudservers/limits", `{"absolute": {
+		"maxTotalInstances": 20, "totalInstancesUsed": 3,
+		"maxTotalCores": 80, "totalCoresUsed": 12,
+		"maxTotalRAMSize": 163840, "totalRAMUsed": 24576
+	}}`)
+
+	quotas, err := listEcsQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []Quota{
+		{Type: "instances", Limit: 20, Used: 3},
+		{Type: "cores", Limit: 80, Used: 12},
+		{Type: "ram", Limit: 163840, Used: 24576},
+		{Type: "server_groups", Limit: -1, Used: 0},
+	}, quotas)
+}
+
+func TestListVpcQuotas(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleTestQuotas(t, "/v1/project-id/quotas", `{"quotas": {"resources": [
+		{"type": "vpc", "quota": 5, "used": 1, "min": 0},
+		{"type": "publicIp", "quota": -1, "used": 4, "min": 0},
+		{"type": "securityGroup", "quota": 100, "used": 7, "min": 0}
+	]}}`)
+
+	quotas, err := listVpcQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []Quota{
+		{Type: "vpc", Limit: 5, Used: 1},
+		{Type: "publicIp", Limit: -1, Used: 4},
+		{Type: "securityGroup", Limit: 100, Used: 7},
+	}, quotas)
+}
+
+func TestListEvsQuotas(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/v2/project-id/os-quota-sets/project-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.AssertEquals(t, "True", r.URL.Query().Get("usage"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"quota_set": {
+			"id": "project-id",
+			"volumes": {"limit": 10, "in_use": 2, "reserved": 0},
+			"gigabytes": {"limit": 1000, "in_use": 140, "reserved": 0},
+			"snapshots": {"limit": -1, "in_use": 0, "reserved": 0}
+		}}`)
+	})
+
+	// the ID of the project is skipped, and the quotas are sorted by the types
+	quotas, err := listEvsQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []Quota{
+		{Type: "gigabytes", Limit: 1000, Used: 140},
+		{Type: "snapshots", Limit: -1, Used: 0},
+		{Type: "volumes", Limit: 10, Used: 2},
+	}, quotas)
+}
+
+func TestListElbQuotas(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleTestQuotas(t, "/v3/project-id/elb/quotas/details", `{"quotas": [
+		{"quota_key": "loadbalancer", "quota_limit": 50, "used": 2, "unit": "count"},
+		{"quota_key": "listener", "quota_limit": -1, "used": 5, "unit": "count"}
+	]}`)
+
+	quotas, err := listElbQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []Quota{
+		{Type: "loadbalancer", Limit: 50, Used: 2},
+		{Type: "listener", Limit: -1, Used: 5},
+	}, quotas)
+}
+
+func TestListQuotas_emptyResponse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleTestQuotas(t, "/v1/project-id/quotas", `{}`)
+	handleTestQuotas(t, "/v3/project-id/elb/quotas/details", `{}`)
+
+	quotas, err := listVpcQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(quotas))
+
+	quotas, err = listElbQuotas(newTestServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(quotas))
+}
@@ -7,10 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
@@ -28,6 +28,7 @@ var pgDatabasePrivilegeNonUpdatableParams = []string{
 // @API RDS POST /v3/{project_id}/instances/{instance_id}/db_privilege
 // @API RDS GET /v3/{project_id}/instances
 // @API RDS DELETE /v3/{project_id}/instances/{instance_id}/db_privilege
+// @API RDS GET /v3/{project_id}/instances/{instance_id}/database/db_user
 func ResourcePgDatabasePrivilege() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePgDatabasePrivilegeCreate,
@@ -35,6 +36,10 @@ func ResourcePgDatabasePrivilege() *schema.Resource {
 		ReadContext:   resourcePgDatabasePrivilegeRead,
 		DeleteContext: resourcePgDatabasePrivilegeDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePgDatabasePrivilegeImportState,
+		},
+
 		CustomizeDiff: config.FlexibleForceNew(pgDatabasePrivilegeNonUpdatableParams),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -67,13 +72,7 @@ func ResourcePgDatabasePrivilege() *schema.Resource {
 				Description: `Specifies the account that associated with the database`,
 			},
 
-			// Internal
-			"enable_force_new": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
-				Description:  utils.SchemaDesc("", utils.SchemaDescInput{Internal: true}),
-			},
+			"enable_force_new": config.EnableForceNewSchema(),
 		},
 	}
 }
@@ -122,11 +121,97 @@ func resourcePgDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceDa
 	dbName := d.Get("db_name").(string)
 	d.SetId(instanceId + "/" + dbName)
 
-	return nil
+	return resourcePgDatabasePrivilegeRead(ctx, d, meta)
 }
 
-func resourcePgDatabasePrivilegeRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+func resourcePgDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+
+	var (
+		product = "rds"
+	)
+	client, err := cfg.NewServiceClient(product, region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	users, err := getPgDatabasePrivilegeUsers(client, d.Get("instance_id").(string), d.Get("db_name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS PostgreSQL database privilege")
+	}
+
+	rst := flattenPgDatabasePrivilegeUsers(d, users)
+	if len(rst) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("users", rst),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func getPgDatabasePrivilegeUsers(client *golangsdk.ServiceClient, instanceId, dbName string) ([]interface{}, error) {
+	httpUrl := "v3/{project_id}/instances/{instance_id}/database/db_user?db-name={db_name}&limit=100"
+
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceId)
+	getPath = strings.ReplaceAll(getPath, "{db_name}", dbName)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+
+	var users []interface{}
+	for page := 1; ; page++ {
+		getResp, err := client.Request("GET", fmt.Sprintf("%s&page=%d", getPath, page), &getOpt)
+		if err != nil {
+			return nil, err
+		}
+		getRespBody, err := utils.FlattenResponse(getResp)
+		if err != nil {
+			return nil, err
+		}
+
+		pageUsers := utils.PathSearch("users", getRespBody, make([]interface{}, 0)).([]interface{})
+		users = append(users, pageUsers...)
+		total := int(utils.PathSearch("total_count", getRespBody, float64(0)).(float64))
+		if len(pageUsers) == 0 || len(users) >= total {
+			return users, nil
+		}
+	}
+}
+
+// flattenPgDatabasePrivilegeUsers only keeps the users managed by the resource, the schema names are not returned by
+// the API, so they are kept from the state. All users are kept if the users are not specified in the import ID.
+func flattenPgDatabasePrivilegeUsers(d *schema.ResourceData, users []interface{}) []map[string]interface{} {
+	schemaNames := make(map[string]string)
+	for _, v := range d.Get("users").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		schemaNames[raw["name"].(string)] = raw["schema_name"].(string)
+	}
+
+	rst := make([]map[string]interface{}, 0, len(users))
+	for _, v := range users {
+		name := utils.PathSearch("name", v, "").(string)
+		schemaName, ok := schemaNames[name]
+		if !ok && len(schemaNames) > 0 {
+			continue
+		}
+		if apiSchemaName := utils.PathSearch("schema_name", v, "").(string); apiSchemaName != "" {
+			schemaName = apiSchemaName
+		}
+		rst = append(rst, map[string]interface{}{
+			"name":        name,
+			"readonly":    utils.PathSearch("readonly", v, false),
+			"schema_name": schemaName,
+		})
+	}
+	return rst
 }
 
 func resourcePgDatabasePrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -159,7 +244,7 @@ func resourcePgDatabasePrivilegeUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	return nil
+	return resourcePgDatabasePrivilegeRead(ctx, d, meta)
 }
 
 func resourcePgDatabasePrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -319,6 +404,39 @@ func buildDeletePgDatabasePrivilegeRequestBodyUser(rawParams interface{}) []map[
 	return nil
 }
 
+func resourcePgDatabasePrivilegeImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid format specified for import id, must be <instance_id>/<db_name> or " +
+			"<instance_id>/<db_name>/<user_name>:<schema_name>,<user_name>:<schema_name>")
+	}
+
+	// the accounts are imported with their schema names, which are not returned by the API, and the read only
+	// permissions are read from the API
+	users := make([]map[string]interface{}, 0)
+	if len(parts) == 3 {
+		for _, v := range strings.Split(parts[2], ",") {
+			name, schemaName, ok := strings.Cut(v, ":")
+			if !ok || name == "" || schemaName == "" {
+				return nil, fmt.Errorf("invalid account %q in the import id, must be <user_name>:<schema_name>", v)
+			}
+			users = append(users, map[string]interface{}{
+				"name":        name,
+				"schema_name": schemaName,
+			})
+		}
+	}
+
+	d.SetId(parts[0] + "/" + parts[1])
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("db_name", parts[1]),
+		d.Set("users", users),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
+
 func rdsInstanceStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		instance, err := GetRdsInstanceByID(client, instanceID)
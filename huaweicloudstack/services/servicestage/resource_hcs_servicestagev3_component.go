@@ -66,6 +66,7 @@ func ResourceV3Component() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			config.FlexibleForceNew(componentNonUpdatableParams),
+			config.SetTagsAllDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -527,7 +528,8 @@ the new value next time the change is made. The corresponding parameter name is
 				},
 				Description: "The configuration of the external accesses.",
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			// HCS unique
 			"auto_lts_config": {
@@ -575,13 +577,8 @@ the new value next time the change is made. The corresponding parameter name is
 				Computed:    true,
 				Description: `The latest update time of the component, in RFC3339 format.`,
 			},
+			"enable_force_new": config.EnableForceNewSchema(),
 			// Internal parameters/attributes.
-			"enable_force_new": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
-				Description:  utils.SchemaDesc("", utils.SchemaDescInput{Internal: true}),
-			},
 			"source_origin": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1023,7 +1020,7 @@ func buildV3ComponentExternalAccesses(accesses *schema.Set) []interface{} {
 	return result
 }
 
-func buildV3ComponentCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+func buildV3ComponentCreateBodyParams(d *schema.ResourceData, cfg *config.HcsConfig) map[string]interface{} {
 	return map[string]interface{}{
 		// Required parameters.
 		"name":            d.Get("name").(string),
@@ -1062,7 +1059,7 @@ func buildV3ComponentCreateBodyParams(d *schema.ResourceData) map[string]interfa
 		"readiness_probe":   utils.ValueIgnoreEmpty(buildV3ComponentProbeConfiguration(d.Get("readiness_probe").([]interface{}))),
 		"external_accesses": utils.ValueIgnoreEmpty(buildV3ComponentExternalAccesses(d.Get("external_accesses").(*schema.Set))),
 		"auto_lts_config":   utils.ValueIgnoreEmpty(buildV3ComponentAutoLtsConfig(d.Get("auto_lts_config").([]interface{}))),
-		"labels":            utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+		"labels":            utils.ExpandResourceTagsMap(utils.GetResourceTags(d, cfg)),
 	}
 }
 
@@ -1142,7 +1139,7 @@ func resourceV3ComponentCreate(ctx context.Context, d *schema.ResourceData, meta
 		MoreHeaders: map[string]string{
 			"Content-Type": "application/json",
 		},
-		JSONBody: utils.RemoveNil(buildV3ComponentCreateBodyParams(d)),
+		JSONBody: utils.RemoveNil(buildV3ComponentCreateBodyParams(d, cfg)),
 	}
 
 	requestResp, err := client.Request("POST", createPath, &opt)
@@ -1534,7 +1531,10 @@ func resourceV3ComponentRead(_ context.Context, d *schema.ResourceData, meta int
 	return diag.FromErr(mErr.ErrorOrNil())
 }
 
-func buildV3ComponentUpdateBodyParams(d *schema.ResourceData) map[string]interface{} {
+// buildV3ComponentUpdateBodyParams builds the request body to update the component, all labels are replaced by the
+// request, so the ignored tags of the remote labels are kept.
+func buildV3ComponentUpdateBodyParams(d *schema.ResourceData, cfg *config.HcsConfig,
+	remoteLabels map[string]string) map[string]interface{} {
 	return map[string]interface{}{
 		// Cannot be updated but the request body needs them.
 		"name":          d.Get("name").(string),
@@ -1571,7 +1571,8 @@ func buildV3ComponentUpdateBodyParams(d *schema.ResourceData) map[string]interfa
 		"liveness_probe":    utils.ValueIgnoreEmpty(buildV3ComponentProbeConfiguration(d.Get("liveness_probe").([]interface{}))),
 		"readiness_probe":   utils.ValueIgnoreEmpty(buildV3ComponentProbeConfiguration(d.Get("readiness_probe").([]interface{}))),
 		"external_accesses": utils.ValueIgnoreEmpty(buildV3ComponentExternalAccesses(d.Get("external_accesses").(*schema.Set))),
-		"labels":            utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+		"labels": utils.ExpandResourceTagsMap(utils.MergeIgnoredTags(cfg, utils.GetResourceTags(d, cfg),
+			remoteLabels)),
 	}
 }
 
@@ -1631,6 +1632,15 @@ func resourceV3ComponentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error creating ServiceStage client: %s", err)
 	}
 
+	respBody, err := QueryV3Component(client, appId, componentId)
+	if err != nil {
+		return diag.Errorf("error getting component (%s): %s", componentId, err)
+	}
+	remoteLabels := make(map[string]string)
+	for k, v := range utils.FlattenTagsToMap(utils.PathSearch("labels", respBody, nil)) {
+		remoteLabels[k] = fmt.Sprint(v)
+	}
+
 	createPath := client.Endpoint + httpUrl
 	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
 	createPath = strings.ReplaceAll(createPath, "{application_id}", appId)
@@ -1641,7 +1651,7 @@ func resourceV3ComponentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		MoreHeaders: map[string]string{
 			"Content-Type": "application/json",
 		},
-		JSONBody: utils.RemoveNil(buildV3ComponentUpdateBodyParams(d)),
+		JSONBody: utils.RemoveNil(buildV3ComponentUpdateBodyParams(d, cfg, remoteLabels)),
 	}
 
 	_, err = client.Request("PUT", createPath, &opt)
@@ -42,6 +42,8 @@ func ResourceSFSTurbo() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -115,7 +117,8 @@ func ResourceSFSTurbo() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			"status": {
 				Type:     schema.TypeString,
@@ -241,7 +244,7 @@ func resourceSFSTurboCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	// add tags
-	if err := utils.CreateResourceTags(sfsClient, d, "sfs-turbo", d.Id()); err != nil {
+	if err := utils.CreateResourceTags(sfsClient, d, cfg, "sfs-turbo", d.Id()); err != nil {
 		return diag.Errorf("error setting tags of SFS Turbo %s: %s", d.Id(), err)
 	}
 
@@ -298,7 +301,7 @@ func resourceSFSTurboRead(_ context.Context, d *schema.ResourceData, meta interf
 	)
 
 	// set tags
-	err = utils.SetResourceTagsToState(d, sfsClient, "sfs-turbo", d.Id())
+	err = utils.SetResourceTagsToState(d, sfsClient, cfg, "sfs-turbo", d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -396,8 +399,8 @@ func resourceSFSTurboUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	// update tags
-	if d.HasChange("tags") {
-		if err := updateSFSTurboTags(sfsClient, d); err != nil {
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateSFSTurboTags(sfsClient, d, cfg); err != nil {
 			return diag.Errorf("error updating tags of SFS Turbo %s: %s", resourceId, err)
 		}
 	}
@@ -446,21 +449,21 @@ func resourceSFSTurboUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	return resourceSFSTurboRead(ctx, d, meta)
 }
 
-func updateSFSTurboTags(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+func updateSFSTurboTags(client *golangsdk.ServiceClient, d *schema.ResourceData, cfg *config.HcsConfig) error {
 	// remove old tags
-	oldKeys := getOldTagKeys(d)
+	oldKeys := getOldTagKeys(d, cfg)
 	if err := utils.DeleteResourceTagsWithKeys(client, oldKeys, "sfs-turbo", d.Id()); err != nil {
 		return err
 	}
 
 	// set new tags
-	return utils.CreateResourceTags(client, d, "sfs-turbo", d.Id())
+	return utils.CreateResourceTags(client, d, cfg, "sfs-turbo", d.Id())
 }
 
-func getOldTagKeys(d *schema.ResourceData) []string {
-	oRaw, _ := d.GetChange("tags")
+func getOldTagKeys(d *schema.ResourceData, cfg *config.HcsConfig) []string {
+	oMap, _ := utils.GetResourceTagsChange(d, cfg)
 	var tagKeys []string
-	if oMap := oRaw.(map[string]interface{}); len(oMap) > 0 {
+	if len(oMap) > 0 {
 		for k := range oMap {
 			tagKeys = append(tagKeys, k)
 		}
@@ -115,7 +115,7 @@ func dataSourceTopicsRead(_ context.Context, d *schema.ResourceData, meta interf
 	for i, item := range filterTopics {
 		topic := item.(topics.TopicGet)
 		ids[i] = topic.TopicUrn
-		stateTopics[i] = flattenSourceTopic(tagClient, topic)
+		stateTopics[i] = flattenSourceTopic(tagClient, cfg, topic)
 	}
 
 	if len(ids) == 1 {
@@ -132,7 +132,7 @@ func dataSourceTopicsRead(_ context.Context, d *schema.ResourceData, meta interf
 	return diag.FromErr(mErr.ErrorOrNil())
 }
 
-func flattenSourceTopic(tagClient *golangsdk.ServiceClient, topic topics.TopicGet) map[string]interface{} {
+func flattenSourceTopic(tagClient *golangsdk.ServiceClient, cfg *config.HcsConfig, topic topics.TopicGet) map[string]interface{} {
 	stateTopic := map[string]interface{}{
 		"topic_urn":    topic.TopicUrn,
 		"id":           topic.TopicUrn,
@@ -142,7 +142,7 @@ func flattenSourceTopic(tagClient *golangsdk.ServiceClient, topic topics.TopicGe
 	}
 
 	if resourceTags, err := tags.Get(tagClient, "smn_topic", topic.Name).Extract(); err == nil {
-		tagmap := utils.TagsToMap(resourceTags.Tags)
+		tagmap := utils.DeleteIgnoredTags(cfg, utils.TagsToMap(resourceTags.Tags))
 		stateTopic["tags"] = tagmap
 	} else {
 		log.Printf("[WARN] fetching tags of SMN topic failed: %s", err)
@@ -30,6 +30,8 @@ func ResourceTopic() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -53,7 +55,8 @@ func ResourceTopic() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 192),
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 
 			"topic_urn": {
 				Type:     schema.TypeString,
@@ -99,7 +102,7 @@ func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	d.SetId(topic.TopicUrn)
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
+	tagRaw := utils.GetResourceTags(d, cfg)
 	if len(tagRaw) > 0 {
 		taglist := utils.ExpandResourceTags(tagRaw)
 		tagClient, err := cfg.SmnV2TagClient(region)
@@ -152,7 +155,9 @@ func resourceTopicRead(_ context.Context, d *schema.ResourceData, meta interface
 	}
 	if resourceTags, err := tags.Get(tagClient, "smn_topic", d.Get("name").(string)).Extract(); err == nil {
 		tagmap := utils.TagsToMap(resourceTags.Tags)
-		mErr = multierror.Append(mErr, d.Set("tags", tagmap))
+		mErr = multierror.Append(mErr,
+			utils.SetTagsAndTagsAll(d, cfg, tagmap),
+		)
 	} else {
 		log.Printf("[WARN] fetching tags of SMN topic failed: %s", err)
 	}
@@ -183,7 +188,7 @@ func resourceTopicUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	// update tags
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagClient, err := cfg.SmnV2TagClient(region)
 		if err != nil {
 			return diag.Errorf("error creating SMN tag client: %s", err)
@@ -191,7 +196,7 @@ func resourceTopicUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		tagClient.MoreHeaders = map[string]string{
 			"X-SMN-RESOURCEID-TYPE": "name",
 		}
-		tagErr := utils.UpdateResourceTags(tagClient, d, "smn_topic", d.Get("name").(string))
+		tagErr := utils.UpdateResourceTags(tagClient, d, cfg, "smn_topic", d.Get("name").(string))
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of SMN topic %s: %s", id, tagErr)
 		}
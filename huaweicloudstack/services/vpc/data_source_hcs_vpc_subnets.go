@@ -4,9 +4,12 @@ import (
 	"context"
 	"log"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/subnets"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -55,6 +58,11 @@ func DataSourceVpcSubnets() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"subnets": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -131,6 +139,19 @@ func DataSourceVpcSubnets() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"dhcp_lease_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ntp_server_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dhcp_domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": common.TagsComputedSchema(),
 					},
 				},
 			},
@@ -146,6 +167,7 @@ func dataSourceVpcSubnetsRead(_ context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error creating VPC client: %s", err)
 	}
 
+	v2Client, err := hcsConfig.NetworkingV2Client(region)
 	if err != nil {
 		return diag.Errorf("error creating VPC V2 client: %s", err)
 	}
@@ -168,9 +190,25 @@ func dataSourceVpcSubnetsRead(_ context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] Retrieved subnets using given filter: %+v", subnetList)
 
+	tagFilter := d.Get("tags").(map[string]interface{})
 	var subnets []map[string]interface{}
 	var ids []string
 	for _, item := range subnetList {
+		var tagMap map[string]string
+		if resourceTags, err := tags.Get(v2Client, "subnets", item.ID).Extract(); err == nil {
+			tagMap = utils.DeleteIgnoredTags(hcsConfig, utils.TagsToMap(resourceTags.Tags)).(map[string]string)
+		} else {
+			log.Printf("[WARN] Error fetching tags of Subnet (%s): %s", item.ID, err)
+		}
+		if !utils.HasMapContains(tagMap, tagFilter) {
+			continue
+		}
+
+		dhcpOpts := make(map[string]string)
+		for _, opt := range item.ExtraDhcpOpts {
+			dhcpOpts[opt.OptName] = opt.OptValue
+		}
+
 		subnet := map[string]interface{}{
 			"id":             item.ID,
 			"name":           item.Name,
@@ -189,6 +227,10 @@ func dataSourceVpcSubnetsRead(_ context.Context, d *schema.ResourceData, meta in
 			"ipv6_subnet_id": item.IPv6SubnetId,
 			"ipv6_cidr":      item.IPv6CIDR,
 			"ipv6_gateway":   item.IPv6Gateway,
+			"tags":           tagMap,
+		}
+		for optName, key := range subnetExtraDhcpOptKeys {
+			subnet[key] = dhcpOpts[optName]
 		}
 
 		subnets = append(subnets, subnet)
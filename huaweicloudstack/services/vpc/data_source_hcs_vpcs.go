@@ -2,15 +2,18 @@ package vpc
 
 import (
 	"context"
-	v3Vpcs "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v3/vpcs"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/vpcs"
+	v3Vpcs "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v3/vpcs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
 
 func DataSourceVpcs() *schema.Resource {
@@ -44,6 +47,11 @@ func DataSourceVpcs() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vpcs": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -78,6 +86,7 @@ func DataSourceVpcs() *schema.Resource {
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"tags": common.TagsComputedSchema(),
 					},
 				},
 			},
@@ -108,9 +117,25 @@ func dataSourceVpcsRead(_ context.Context, d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Retrieved Vpc using given filter: %+v", vpcList)
 
+	vpcV2Client, err := hcsConfig.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2 client: %s", err)
+	}
+
+	tagFilter := d.Get("tags").(map[string]interface{})
 	var vpcs []map[string]interface{}
 	var ids []string
 	for _, vpcResource := range vpcList {
+		var tagMap map[string]string
+		if resourceTags, err := tags.Get(vpcV2Client, "vpcs", vpcResource.ID).Extract(); err == nil {
+			tagMap = utils.DeleteIgnoredTags(hcsConfig, utils.TagsToMap(resourceTags.Tags)).(map[string]string)
+		} else {
+			log.Printf("[WARN] Error fetching tags of VPC (%s): %s", vpcResource.ID, err)
+		}
+		if !utils.HasMapContains(tagMap, tagFilter) {
+			continue
+		}
+
 		vpc := map[string]interface{}{
 			"id":                    vpcResource.ID,
 			"name":                  vpcResource.Name,
@@ -118,11 +143,9 @@ func dataSourceVpcsRead(_ context.Context, d *schema.ResourceData, meta interfac
 			"enterprise_project_id": vpcResource.EnterpriseProjectID,
 			"status":                vpcResource.Status,
 			"description":           vpcResource.Description,
+			"tags":                  tagMap,
 		}
 
-		vpcs = append(vpcs, vpc)
-		ids = append(ids, vpcResource.ID)
-
 		// save VirtualPrivateCloudV3 extend_cidr
 		vpcV3Client, v3Err := hcsConfig.NetworkingV3Client(hcsConfig.GetRegion(d))
 		if v3Err != nil {
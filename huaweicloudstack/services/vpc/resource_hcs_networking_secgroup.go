@@ -20,6 +20,7 @@ import (
 	v2groups "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v2/extensions/security/groups"
 	v3groups "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v3/security/groups"
 	v3rules "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v3/security/rules"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/quota"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/fmtp"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/logp"
 )
@@ -88,6 +89,7 @@ func ResourceNetworkingSecGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: quota.PrecheckDiff("vpc", "securityGroup", nil),
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -36,6 +36,8 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(3 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{ // request and response parameters
 			"region": {
 				Type:     schema.TypeString,
@@ -57,6 +59,15 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: utils.ValidateCIDR,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile("^[^<>]*$"),
+						"The angle brackets (< and >) are not allowed."),
+				),
+			},
 			"secondary_cidrs": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -72,6 +83,8 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -106,8 +119,9 @@ func resourceVirtualPrivateCloudCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	createOpts := vpcs.CreateOpts{
-		Name: d.Get("name").(string),
-		CIDR: d.Get("cidr").(string),
+		Name:        d.Get("name").(string),
+		CIDR:        d.Get("cidr").(string),
+		Description: d.Get("description").(string),
 	}
 
 	epsID := common.GetEnterpriseProjectID(d, config)
@@ -158,6 +172,20 @@ func resourceVirtualPrivateCloudCreate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// set tags
+	if len(utils.GetResourceTags(d, config)) > 0 {
+		vpcV2Client, err := config.NetworkingV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating VPC v2 client: %s", err)
+		}
+		if err := utils.CreateResourceTags(vpcV2Client, d, config, "vpcs", d.Id()); err != nil {
+			return diag.Errorf("error setting tags of VPC (%s): %s", d.Id(), err)
+		}
+		if err := d.Set("tags_all", utils.GetResourceTags(d, config)); err != nil {
+			return diag.Errorf("error saving tags_all of VPC (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceVirtualPrivateCloudRead(ctx, d, meta)
 }
 
@@ -180,6 +208,7 @@ func resourceVirtualPrivateCloudRead(_ context.Context, d *schema.ResourceData,
 
 	d.Set("name", n.Name)
 	d.Set("cidr", n.CIDR)
+	d.Set("description", n.Description)
 	d.Set("status", n.Status)
 	d.Set("enterprise_project_id", n.EnterpriseProjectID)
 	d.Set("region", config.GetRegion(d))
@@ -204,6 +233,15 @@ func resourceVirtualPrivateCloudRead(_ context.Context, d *schema.ResourceData,
 	}
 	d.Set("secondary_cidrs", res.ExtendCidrs)
 
+	// save VPC tags
+	vpcV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v2 client: %s", err)
+	}
+	if err := utils.SetResourceTagsToState(d, vpcV2Client, config, "vpcs", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -216,11 +254,15 @@ func resourceVirtualPrivateCloudUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	vpcID := d.Id()
-	if d.HasChanges("name", "cidr") {
+	if d.HasChanges("name", "cidr", "description") {
 		updateOpts := vpcs.UpdateOpts{
 			Name: d.Get("name").(string),
 			CIDR: d.Get("cidr").(string),
 		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
 
 		_, err = vpcs.Update(vpcClient, vpcID, updateOpts).Extract()
 		if err != nil {
@@ -258,6 +300,18 @@ func resourceVirtualPrivateCloudUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// update tags
+	if d.HasChanges("tags", "tags_all") {
+		vpcV2Client, err := config.NetworkingV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating VPC v2 client: %s", err)
+		}
+
+		if err := utils.UpdateResourceTags(vpcV2Client, d, config, "vpcs", vpcID); err != nil {
+			return diag.Errorf("error updating tags of VPC (%s): %s", vpcID, err)
+		}
+	}
+
 	return resourceVirtualPrivateCloudRead(ctx, d, meta)
 }
 
@@ -17,7 +17,6 @@ import (
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
-	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/networking/v1/subnets"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
 )
@@ -41,6 +40,13 @@ var defaultDNSList = map[string][]string{
 	"sa-chile-1":     {"100.125.1.250", "100.125.0.250"},   // LA-Santiago2
 }
 
+// subnetExtraDhcpOptKeys maps the names of the extra DHCP options to the schema keys.
+var subnetExtraDhcpOptKeys = map[string]string{
+	"addresstime": "dhcp_lease_time",
+	"ntp":         "ntp_server_address",
+	"domainname":  "dhcp_domain_name",
+}
+
 // ResourceSubnetDNSListV1 is used to obtain the corresponding DNS list according to the region.
 func ResourceSubnetDNSListV1(d *schema.ResourceData, region string) []string {
 	rawDNSN := d.Get("dns_list").([]interface{})
@@ -72,6 +78,8 @@ func ResourceVpcSubnetV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: config.SetTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{ // request and response parameters
 			"region": {
 				Type:     schema.TypeString,
@@ -150,6 +158,25 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Optional:   true,
 				Deprecated: "use availability_zone instead",
 			},
+			"dhcp_lease_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(-1|[1-9][0-9]*h)$`),
+					"the value must be -1 (unlimited) or a number of hours followed by h, such as 24h"),
+			},
+			"ntp_server_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dhcp_domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subnet_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -171,7 +198,8 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":     common.TagsSchema(),
+			"tags_all": common.TagsComputedSchema(),
 		},
 	}
 }
@@ -197,6 +225,7 @@ func resourceVpcSubnetCreate(ctx context.Context, d *schema.ResourceData, meta i
 		PRIMARY_DNS:      d.Get("primary_dns").(string),
 		SECONDARY_DNS:    d.Get("secondary_dns").(string),
 		DnsList:          ResourceSubnetDNSListV1(d, region),
+		ExtraDhcpOpts:    buildSubnetExtraDhcpOpts(d, false),
 	}
 	log.Printf("[DEBUG] Create VPC subnet options: %#v", createOpts)
 
@@ -225,22 +254,59 @@ func resourceVpcSubnetCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	// set tags
-	tagRaw := d.Get("tags").(map[string]interface{})
-	if len(tagRaw) > 0 {
+	if tagRaw := utils.GetResourceTags(d, config); len(tagRaw) > 0 {
 		vpcSubnetV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
 		if err != nil {
 			return diag.Errorf("error creating VpcSubnet client: %s", err)
 		}
-		taglist := utils.ExpandResourceTags(tagRaw)
-		if tagErr := tags.Create(vpcSubnetV2Client, "subnets", n.ID, taglist).ExtractErr(); tagErr != nil {
+		if tagErr := utils.CreateResourceTags(vpcSubnetV2Client, d, config, "subnets", n.ID); tagErr != nil {
 			return diag.Errorf("error setting tags of VpcSubnet %q: %s", n.ID, tagErr)
 		}
+		if err := d.Set("tags_all", tagRaw); err != nil {
+			return diag.Errorf("error saving tags_all of VpcSubnet %q: %s", n.ID, err)
+		}
 	}
 
 	return resourceVpcSubnetRead(ctx, d, config)
 
 }
 
+// buildSubnetExtraDhcpOpts builds the extra DHCP options of the subnet, the options which are removed from the
+// configuration are reset by the null values when updating.
+func buildSubnetExtraDhcpOpts(d *schema.ResourceData, isUpdate bool) []subnets.ExtraDhcpOpt {
+	var result []subnets.ExtraDhcpOpt
+	for optName, key := range subnetExtraDhcpOptKeys {
+		if isUpdate && !d.HasChange(key) {
+			continue
+		}
+
+		opt := subnets.ExtraDhcpOpt{
+			OptName: optName,
+		}
+		if v, ok := d.GetOk(key); ok {
+			value := v.(string)
+			opt.OptValue = &value
+		} else if !isUpdate {
+			continue
+		}
+		result = append(result, opt)
+	}
+	return result
+}
+
+func flattenSubnetExtraDhcpOpts(d *schema.ResourceData, opts []subnets.ExtraDhcp) error {
+	values := make(map[string]string)
+	for _, opt := range opts {
+		values[opt.OptName] = opt.OptValue
+	}
+
+	mErr := &multierror.Error{}
+	for optName, key := range subnetExtraDhcpOptKeys {
+		mErr = multierror.Append(mErr, d.Set(key, values[optName]))
+	}
+	return mErr.ErrorOrNil()
+}
+
 // GetVpcSubnetById is a method to obtain subnet informations from special region through subnet ID.
 func GetVpcSubnetById(config *config.HcsConfig, region, networkId string) (*subnets.Subnet, error) {
 	subnetClient, err := config.NetworkingV1Client(region)
@@ -277,19 +343,22 @@ func resourceVpcSubnetRead(_ context.Context, d *schema.ResourceData, meta inter
 		d.Set("ipv6_subnet_id", n.IPv6SubnetId),
 		d.Set("ipv6_cidr", n.IPv6CIDR),
 		d.Set("ipv6_gateway", n.IPv6Gateway),
+		flattenSubnetExtraDhcpOpts(d, n.ExtraDhcpOpts),
 	)
 
-	// save VpcSubnet tags
-	if vpcSubnetV2Client, err := config.NetworkingV2Client(region); err == nil {
-		if resourceTags, err := tags.Get(vpcSubnetV2Client, "subnets", d.Id()).Extract(); err == nil {
-			tagmap := utils.TagsToMap(resourceTags.Tags)
-			mErr = multierror.Append(mErr, d.Set("tags", tagmap))
-		} else {
-			log.Printf("[WARN] Error fetching tags of Subnet (%s): %s", d.Id(), err)
-		}
+	// the subnet belongs to the enterprise project of the VPC
+	if vpc, err := GetVpcById(config, region, n.VPC_ID); err == nil {
+		mErr = multierror.Append(mErr, d.Set("enterprise_project_id", vpc.EnterpriseProjectID))
 	} else {
+		log.Printf("[WARN] Error fetching the VPC (%s) of Subnet (%s): %s", n.VPC_ID, d.Id(), err)
+	}
+
+	// save VpcSubnet tags
+	vpcSubnetV2Client, err := config.NetworkingV2Client(region)
+	if err != nil {
 		return diag.Errorf("error creating VpcSubnet client: %s", err)
 	}
+	mErr = multierror.Append(mErr, utils.SetResourceTagsToState(d, vpcSubnetV2Client, config, "subnets", d.Id()))
 
 	if err := mErr.ErrorOrNil(); err != nil {
 		return diag.Errorf("error setting VPC subnet fields: %s", err)
@@ -305,7 +374,8 @@ func resourceVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error creating networking client: %s", err)
 	}
 
-	if d.HasChanges("name", "description", "dhcp_enable", "primary_dns", "secondary_dns", "dns_list", "ipv6_enable") {
+	if d.HasChanges("name", "description", "dhcp_enable", "primary_dns", "secondary_dns", "dns_list", "ipv6_enable",
+		"dhcp_lease_time", "ntp_server_address", "dhcp_domain_name") {
 		var updateOpts subnets.UpdateOpts
 
 		// name is mandatory while updating subnet
@@ -335,6 +405,7 @@ func resourceVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			dnsList := ResourceSubnetDNSListV1(d, "")
 			updateOpts.DnsList = &dnsList
 		}
+		updateOpts.ExtraDhcpOpts = buildSubnetExtraDhcpOpts(d, true)
 
 		log.Printf("[DEBUG] Update VPC subnet options: %#v", updateOpts)
 		vpcID := d.Get("vpc_id").(string)
@@ -345,13 +416,13 @@ func resourceVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	// update tags
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcSubnetV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
 		if err != nil {
 			return diag.Errorf("error creating VpcSubnet client: %s", err)
 		}
 
-		tagErr := utils.UpdateResourceTags(vpcSubnetV2Client, d, "subnets", d.Id())
+		tagErr := utils.UpdateResourceTags(vpcSubnetV2Client, d, config, "subnets", d.Id())
 		if tagErr != nil {
 			return diag.Errorf("error updating tags of VPC subnet %s: %s", d.Id(), tagErr)
 		}
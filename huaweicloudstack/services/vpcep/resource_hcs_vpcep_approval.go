@@ -6,10 +6,12 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/vpcep/v1/services"
@@ -32,6 +34,10 @@ func ResourceVPCEndpointApproval() *schema.Resource {
 		UpdateContext: resourceVPCEndpointApprovalUpdate,
 		DeleteContext: resourceVPCEndpointApprovalDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(3 * time.Minute),
@@ -119,12 +125,41 @@ func resourceVPCEndpointApprovalRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("error creating VPC endpoint client: %s", err)
 	}
 
-	serviceID := d.Get("service_id").(string)
-	if conns, err := flattenVPCEndpointConnections(vpcepClient, serviceID); err == nil {
-		d.Set("connections", conns)
+	serviceID := d.Id()
+	if _, err := services.Get(vpcepClient, serviceID).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPC endpoint service")
 	}
 
-	return nil
+	conns, err := flattenVPCEndpointConnections(vpcepClient, serviceID)
+	if err != nil {
+		return diag.Errorf("error retrieving connections of VPC endpoint service %s: %s", serviceID, err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("service_id", serviceID),
+		d.Set("connections", conns),
+		d.Set("endpoints", flattenVPCEndpointApprovalEndpoints(d, conns)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// flattenVPCEndpointApprovalEndpoints returns the accepted endpoints which are managed by the resource, all accepted
+// endpoints are returned during import.
+func flattenVPCEndpointApprovalEndpoints(d *schema.ResourceData, conns []map[string]interface{}) []string {
+	managed := d.Get("endpoints").(*schema.Set)
+	endpoints := make([]string, 0, len(conns))
+	for _, conn := range conns {
+		epID := conn["endpoint_id"].(string)
+		if conn["status"] != approvalActionStatusMap[actionReceive] {
+			continue
+		}
+		if managed.Len() > 0 && !managed.Contains(epID) {
+			continue
+		}
+		endpoints = append(endpoints, epID)
+	}
+	return endpoints
 }
 
 func resourceVPCEndpointApprovalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -12,10 +13,159 @@ import (
 
 const SysTagKeyEnterpriseProjectId = "_sys_enterprise_project_id"
 
+// IgnoreTagsConfig is the settings of the tags which are ignored when the tags are read from the API.
+type IgnoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// TagsConfig is the provider-level settings of the tags, it is implemented by the provider configuration,
+// so each provider instance (such as an alias) keeps its own settings.
+type TagsConfig interface {
+	// GetDefaultTags returns the default tags which are merged into the tags of every taggable resource.
+	GetDefaultTags() map[string]string
+	// GetIgnoreTags returns the settings of the tags which are managed outside of Terraform.
+	GetIgnoreTags() IgnoreTagsConfig
+}
+
+// IsIgnoredTagKey checks whether the tag key is ignored, the system tag of the enterprise project is always ignored.
+func IsIgnoredTagKey(conf TagsConfig, key string) bool {
+	if key == SysTagKeyEnterpriseProjectId {
+		return true
+	}
+	if conf == nil {
+		return false
+	}
+
+	ignoreTags := conf.GetIgnoreTags()
+	for _, k := range ignoreTags.Keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range ignoreTags.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeIgnoredTags returns the tags with the ignored tags of the remote tags, it is used by the APIs which replace
+// all tags of a resource, so that the tags managed outside of Terraform are kept.
+func MergeIgnoredTags(conf TagsConfig, tags map[string]interface{}, remoteTags map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range remoteTags {
+		if IsIgnoredTagKey(conf, k) {
+			result[k] = v
+		}
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
+func getDefaultTags(conf TagsConfig) map[string]string {
+	if conf == nil {
+		return nil
+	}
+	return conf.GetDefaultTags()
+}
+
+// MergeDefaultTags returns the effective tags of a resource, the resource-level tags take precedence
+// over the provider-level default tags.
+func MergeDefaultTags(conf TagsConfig, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range getDefaultTags(conf) {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
+// GetResourceTags returns the effective tags of a resource, which are the resource-level tags merged with
+// the provider-level default tags. It expects the schema name must be "tags", and the default tags are only
+// merged into the resources which have the "tags_all" attribute.
+func GetResourceTags(d *schema.ResourceData, conf TagsConfig) map[string]interface{} {
+	tagRaw, _ := d.Get("tags").(map[string]interface{})
+	if !hasTagsAll(d) {
+		return tagRaw
+	}
+	return MergeDefaultTags(conf, tagRaw)
+}
+
+// hasTagsAll checks whether the resource has the "tags_all" attribute, the value of a key which is not
+// defined in the schema is nil.
+func hasTagsAll(d *schema.ResourceData) bool {
+	_, ok := d.Get("tags_all").(map[string]interface{})
+	return ok
+}
+
+// SetTagsAllDiff plans the computed "tags_all" attribute as the effective tags, which are the provider-level
+// default tags merged with the tags in the configuration.
+func SetTagsAllDiff(d *schema.ResourceDiff, conf TagsConfig) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagRaw, _ := d.Get("tags").(map[string]interface{})
+	return d.SetNew("tags_all", MergeDefaultTags(conf, tagRaw))
+}
+
+// SetTagsAndTagsAll saves the tags read from the API to the state. The ignored tags are dropped, all of the others
+// are saved in "tags_all", and the default tags which are not specified in the resource are excluded from "tags",
+// so that the changes of the provider-level default tags are planned through "tags_all".
+// The tagMap can be a map[string]string or a map[string]interface{}.
+func SetTagsAndTagsAll(d *schema.ResourceData, conf TagsConfig, tagMap interface{}) error {
+	tagStrMap := make(map[string]string)
+	switch v := tagMap.(type) {
+	case map[string]string:
+		for key, value := range v {
+			tagStrMap[key] = value
+		}
+	case map[string]interface{}:
+		for key, value := range v {
+			tagStrMap[key] = fmt.Sprint(value)
+		}
+	}
+	DeleteIgnoredTags(conf, tagStrMap)
+
+	if !hasTagsAll(d) {
+		return d.Set("tags", tagStrMap)
+	}
+
+	if err := d.Set("tags_all", tagStrMap); err != nil {
+		return err
+	}
+	return d.Set("tags", RemoveDefaultTags(d, conf, tagStrMap))
+}
+
+// RemoveDefaultTags returns the tags without the provider-level default tags which have the default values and
+// are not specified in the "tags" of the resource.
+func RemoveDefaultTags(d *schema.ResourceData, conf TagsConfig, tagMap map[string]string) map[string]string {
+	configured, _ := d.Get("tags").(map[string]interface{})
+	defaults := getDefaultTags(conf)
+
+	result := make(map[string]string, len(tagMap))
+	for k, v := range tagMap {
+		if defaultValue, ok := defaults[k]; ok && defaultValue == v {
+			if _, isConfigured := configured[k]; !isConfigured {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
 // CreateResourceTags is a helper to create the tags for a resource.
 // It expects the schema name must be "tags"
-func CreateResourceTags(client *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, id string) error {
-	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+func CreateResourceTags(client *golangsdk.ServiceClient, d *schema.ResourceData, conf TagsConfig,
+	resourceType, id string) error {
+	if tagRaw := GetResourceTags(d, conf); len(tagRaw) > 0 {
 		tagList := ExpandResourceTags(tagRaw)
 		return tags.Create(client, resourceType, id, tagList).ExtractErr()
 	}
@@ -23,12 +173,11 @@ func CreateResourceTags(client *golangsdk.ServiceClient, d *schema.ResourceData,
 }
 
 // UpdateResourceTags is a helper to update the tags for a resource.
-// It expects the tags field to be named "tags"
-func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, id string) error {
-	if d.HasChange("tags") {
-		oRaw, nRaw := d.GetChange("tags")
-		oMap := oRaw.(map[string]interface{})
-		nMap := nRaw.(map[string]interface{})
+// It expects the tags field to be named "tags", and the effective tags are saved in "tags_all" if exists.
+func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, conf TagsConfig,
+	resourceType, id string) error {
+	if d.HasChanges("tags", "tags_all") {
+		oMap, nMap := GetResourceTagsChange(d, conf)
 
 		// remove old tags
 		if len(oMap) > 0 {
@@ -52,6 +201,19 @@ func UpdateResourceTags(conn *golangsdk.ServiceClient, d *schema.ResourceData, r
 	return nil
 }
 
+// GetResourceTagsChange returns the old and new effective tags of a resource.
+// The old value is the "tags_all" in state, and falls back to the "tags" if "tags_all" is not saved.
+func GetResourceTagsChange(d *schema.ResourceData, conf TagsConfig) (oldTags, newTags map[string]interface{}) {
+	oRaw, _ := d.GetChange("tags_all")
+	if oMap, ok := oRaw.(map[string]interface{}); ok && len(oMap) > 0 {
+		oldTags = oMap
+	} else {
+		oRaw, _ = d.GetChange("tags")
+		oldTags, _ = oRaw.(map[string]interface{})
+	}
+	return oldTags, GetResourceTags(d, conf)
+}
+
 // CreateResourceTagsWithKeys is a helper to create the tags with tagKeys for a resource.
 func CreateResourceTagsWithKeys(client *golangsdk.ServiceClient, tagKeys []string, resourceType, id string) error {
 	for _, key := range tagKeys {
@@ -82,11 +244,12 @@ func DeleteResourceTagsWithKeys(client *golangsdk.ServiceClient, tagKeys []strin
 
 // SetResourceTagsToState is a helper to query tags of resource, then set to state.
 // The schema argument name must be: tags
-func SetResourceTagsToState(d *schema.ResourceData, client *golangsdk.ServiceClient, resourceType, id string) error {
+func SetResourceTagsToState(d *schema.ResourceData, client *golangsdk.ServiceClient, conf TagsConfig,
+	resourceType, id string) error {
 	// set tags
 	if resourceTags, err := tags.Get(client, resourceType, id).Extract(); err == nil {
 		tagmap := TagsToMap(resourceTags.Tags)
-		if err := d.Set("tags", tagmap); err != nil {
+		if err := SetTagsAndTagsAll(d, conf, tagmap); err != nil {
 			return fmt.Errorf("error saving tags to state for %s (%s): %s", resourceType, id, err)
 		}
 	} else {
@@ -109,6 +272,19 @@ func TagsToMap(tags []tags.ResourceTag) map[string]string {
 	return result
 }
 
+// FlattenKeyValueTags returns the list of tags in format of key.value, such as the tags of the ECS and BMS instances,
+// into a map, the ignored tags are excluded.
+func FlattenKeyValueTags(conf TagsConfig, tags []string) map[string]string {
+	result := make(map[string]string)
+	for _, tagStr := range tags {
+		tag := strings.SplitN(tagStr, ".", 2)
+		if len(tag) == 2 && !IsIgnoredTagKey(conf, tag[0]) {
+			result[tag[0]] = tag[1]
+		}
+	}
+	return result
+}
+
 // FlattenTagsToMap returns the list of tags into a map.
 func FlattenTagsToMap(tags interface{}) map[string]interface{} {
 	if tagArray, ok := tags.([]interface{}); ok {
@@ -231,27 +407,42 @@ func BuildSysTags(enterpriseProjectID string) (enterpriseProjectTags []tags.Reso
 // 2. map[string]interface{}: a map containing tags
 // 3. []map[string]interface{}: a slice of maps containing tags
 func DeleteEnterpriseProjectIdFromTags(tags interface{}) interface{} {
+	return deleteTagsByKey(tags, func(key string) bool {
+		return key == SysTagKeyEnterpriseProjectId
+	})
+}
+
+// DeleteIgnoredTags deletes the tags ignored by the provider-level ignore_tags settings, and the
+// SysTagKeyEnterpriseProjectId, from tags. The supported types are the same as DeleteEnterpriseProjectIdFromTags,
+// and map[string]string is also supported.
+func DeleteIgnoredTags(conf TagsConfig, tags interface{}) interface{} {
+	return deleteTagsByKey(tags, func(key string) bool {
+		return IsIgnoredTagKey(conf, key)
+	})
+}
+
+func deleteTagsByKey(tags interface{}, shouldDelete func(key string) bool) interface{} {
 	switch v := tags.(type) {
 	case map[string]interface{}:
-		delete(v, SysTagKeyEnterpriseProjectId)
+		for key := range v {
+			if shouldDelete(key) {
+				delete(v, key)
+			}
+		}
+		return v
+	case map[string]string:
+		for key := range v {
+			if shouldDelete(key) {
+				delete(v, key)
+			}
+		}
 		return v
 	case []map[string]interface{}:
 		for _, tagMap := range v {
-			delete(tagMap, SysTagKeyEnterpriseProjectId)
+			deleteTagsByKey(tagMap, shouldDelete)
 		}
 		return v
 	default:
-		// If tags is an interface{} that contains a map or slice of maps
-		if tagMap, ok := v.(map[string]interface{}); ok {
-			delete(tagMap, SysTagKeyEnterpriseProjectId)
-			return tagMap
-		}
-		if tagSlice, ok := v.([]map[string]interface{}); ok {
-			for _, tagMap := range tagSlice {
-				delete(tagMap, SysTagKeyEnterpriseProjectId)
-			}
-			return tagSlice
-		}
 		// Return the original value if type is not supported
 		return tags
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
@@ -81,3 +83,132 @@ func TestAccFunction_jsonStringsEqual(t *testing.T) {
 	}
 	t.Logf("The processing result of function 'JSONStringsEqual' meets expectation: %s", green(true))
 }
+
+type testTagsConfig struct {
+	defaultTags map[string]string
+	ignoreTags  IgnoreTagsConfig
+}
+
+func (c testTagsConfig) GetDefaultTags() map[string]string {
+	return c.defaultTags
+}
+
+func (c testTagsConfig) GetIgnoreTags() IgnoreTagsConfig {
+	return c.ignoreTags
+}
+
+func TestAccFunction_mergeDefaultTags(t *testing.T) {
+	var (
+		conf      = testTagsConfig{defaultTags: map[string]string{"owner": "ops", "env": "default"}}
+		testInput = map[string]interface{}{"env": "test", "app": "demo"}
+		expected  = map[string]interface{}{"owner": "ops", "env": "test", "app": "demo"}
+	)
+
+	result := MergeDefaultTags(conf, testInput)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'MergeDefaultTags' is not as expected, want %s, but got %s",
+			green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'MergeDefaultTags' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_setTagsAndTagsAll(t *testing.T) {
+	var (
+		conf = testTagsConfig{
+			defaultTags: map[string]string{"owner": "ops", "env": "default"},
+			ignoreTags:  IgnoreTagsConfig{Keys: []string{"_hcs_managed"}},
+		}
+		resource = map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		}
+		// The "owner" is inherited from the default tags, the "env" has the default value but is specified in the resource.
+		apiTags     = map[string]string{"owner": "ops", "env": "default", "app": "demo", "_hcs_managed": "true"}
+		expected    = map[string]interface{}{"env": "default", "app": "demo"}
+		expectedAll = map[string]interface{}{"owner": "ops", "env": "default", "app": "demo"}
+	)
+
+	d := schema.TestResourceDataRaw(t, resource, map[string]interface{}{
+		"tags": map[string]interface{}{"env": "default", "app": "demo"},
+	})
+	if err := SetTagsAndTagsAll(d, conf, apiTags); err != nil {
+		t.Fatalf("error saving the tags: %s", err)
+	}
+
+	if result := d.Get("tags"); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'SetTagsAndTagsAll' is not as expected, want %s, "+
+			"but got %s", green(expected), yellow(result))
+	}
+	if result := d.Get("tags_all"); !reflect.DeepEqual(result, expectedAll) {
+		t.Fatalf("The processing result of the function 'SetTagsAndTagsAll' is not as expected, want %s, "+
+			"but got %s", green(expectedAll), yellow(result))
+	}
+	t.Logf("The processing result of function 'SetTagsAndTagsAll' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_deleteIgnoredTags(t *testing.T) {
+	var (
+		conf = testTagsConfig{
+			ignoreTags: IgnoreTagsConfig{Keys: []string{"_hcs_managed"}, KeyPrefixes: []string{"sys_"}},
+		}
+		testInput = map[string]interface{}{
+			"_hcs_managed":               "true",
+			"sys_owner":                  "ops",
+			"_sys_enterprise_project_id": "0",
+			"owner":                      "dev",
+		}
+		expected = map[string]interface{}{
+			"owner": "dev",
+		}
+	)
+
+	result := DeleteIgnoredTags(conf, testInput)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'DeleteIgnoredTags' is not as expected, want %s, but got %s",
+			green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'DeleteIgnoredTags' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_mergeIgnoredTags(t *testing.T) {
+	var (
+		conf = testTagsConfig{
+			ignoreTags: IgnoreTagsConfig{KeyPrefixes: []string{"sys_"}},
+		}
+		testInput  = map[string]interface{}{"owner": "dev", "sys_owner": "dev"}
+		remoteTags = map[string]string{"owner": "ops", "app": "demo", "sys_owner": "ops", "sys_env": "test"}
+		expected   = map[string]interface{}{"owner": "dev", "sys_owner": "dev", "sys_env": "test"}
+	)
+
+	result := MergeIgnoredTags(conf, testInput, remoteTags)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'MergeIgnoredTags' is not as expected, want %s, but got %s",
+			green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'MergeIgnoredTags' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_flattenKeyValueTags(t *testing.T) {
+	var (
+		conf = testTagsConfig{
+			ignoreTags: IgnoreTagsConfig{KeyPrefixes: []string{"sys_"}},
+		}
+		testInput = []string{"owner.dev", "version.1.0", "sys_owner.ops", "_sys_enterprise_project_id.0", "invalid"}
+		expected  = map[string]string{"owner": "dev", "version": "1.0"}
+	)
+
+	result := FlattenKeyValueTags(conf, testInput)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'FlattenKeyValueTags' is not as expected, want %s, "+
+			"but got %s", green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'FlattenKeyValueTags' meets expectation: %s", green(expected))
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/generate"
 )
 
 func main() {
@@ -13,6 +16,15 @@ func main() {
 	// prevent duplicate timestamp and incorrect log level setting
 	log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
 
+	// the generate command writes the configuration of the existing resources instead of serving the plugin
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(huaweicloudstack.Provider(), os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: huaweicloudstack.Provider})
 }